@@ -11,6 +11,7 @@ import (
 	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/security_policies"
 	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	client2 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains/security_policies"
+	client3 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/security_policies"
 
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
@@ -31,10 +32,13 @@ func NewRulesClient(sessionContext utl.SessionContext, connector vapiProtocolCli
 	case utl.Multitenancy:
 		client = client2.NewRulesClient(connector)
 
+	case utl.VPC:
+		client = client3.NewRulesClient(connector)
+
 	default:
 		return nil
 	}
-	return &RuleClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID}
+	return &RuleClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
 }
 
 func (c RuleClientContext) Get(domainIdParam string, securityPolicyIdParam string, ruleIdParam string) (model0.Rule, error) {
@@ -67,6 +71,13 @@ func (c RuleClientContext) Get(domainIdParam string, securityPolicyIdParam strin
 			return obj, err
 		}
 
+	case utl.VPC:
+		client := c.Client.(client3.RulesClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, c.VPCID, securityPolicyIdParam, ruleIdParam)
+		if err != nil {
+			return obj, err
+		}
+
 	default:
 		return obj, errors.New("invalid infrastructure for model")
 	}
@@ -90,6 +101,10 @@ func (c RuleClientContext) Delete(domainIdParam string, securityPolicyIdParam st
 		client := c.Client.(client2.RulesClient)
 		err = client.Delete(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, ruleIdParam)
 
+	case utl.VPC:
+		client := c.Client.(client3.RulesClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, c.VPCID, securityPolicyIdParam, ruleIdParam)
+
 	default:
 		err = errors.New("invalid infrastructure for model")
 	}
@@ -117,6 +132,10 @@ func (c RuleClientContext) Patch(domainIdParam string, securityPolicyIdParam str
 		client := c.Client.(client2.RulesClient)
 		err = client.Patch(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, ruleIdParam, ruleParam)
 
+	case utl.VPC:
+		client := c.Client.(client3.RulesClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, c.VPCID, securityPolicyIdParam, ruleIdParam, ruleParam)
+
 	default:
 		err = errors.New("invalid infrastructure for model")
 	}
@@ -153,6 +172,10 @@ func (c RuleClientContext) Update(domainIdParam string, securityPolicyIdParam st
 		client := c.Client.(client2.RulesClient)
 		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, ruleIdParam, ruleParam)
 
+	case utl.VPC:
+		client := c.Client.(client3.RulesClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, c.VPCID, securityPolicyIdParam, ruleIdParam, ruleParam)
+
 	default:
 		err = errors.New("invalid infrastructure for model")
 	}
@@ -185,6 +208,10 @@ func (c RuleClientContext) List(domainIdParam string, securityPolicyIdParam stri
 		client := c.Client.(client2.RulesClient)
 		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, domainIdParam, securityPolicyIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
 
+	case utl.VPC:
+		client := c.Client.(client3.RulesClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, c.VPCID, securityPolicyIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
 	default:
 		err = errors.New("invalid infrastructure for model")
 	}
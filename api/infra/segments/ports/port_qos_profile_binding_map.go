@@ -0,0 +1,79 @@
+//nolint:revive
+package ports
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments/ports"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments/ports"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PortQosProfileBindingMapClientContext utl.ClientContext
+
+func NewPortQosProfileBindingMapsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PortQosProfileBindingMapClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewPortQosProfileBindingMapsClient(connector)
+
+	case utl.Multitenancy:
+		client = client1.NewPortQosProfileBindingMapsClient(connector)
+
+	default:
+		return nil
+	}
+	return &PortQosProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c PortQosProfileBindingMapClientContext) Get(infraSegmentIdParam string, infraPortIdParam string, portQosProfileBindingMapIdParam string) (model0.PortQosProfileBindingMap, error) {
+	var obj model0.PortQosProfileBindingMap
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortQosProfileBindingMapsClient)
+		obj, err = client.Get(infraSegmentIdParam, infraPortIdParam, portQosProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortQosProfileBindingMapsClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, portQosProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PortQosProfileBindingMapClientContext) List(infraSegmentIdParam string, infraPortIdParam string, cursorParam *string, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PortQosProfileBindingMapListResult, error) {
+	var err error
+	var obj model0.PortQosProfileBindingMapListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortQosProfileBindingMapsClient)
+		obj, err = client.List(infraSegmentIdParam, infraPortIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortQosProfileBindingMapsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, cursorParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
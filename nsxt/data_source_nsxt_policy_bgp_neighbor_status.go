@@ -0,0 +1,178 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/bgp/neighbors"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyBgpNeighborStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyBgpNeighborStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"gateway_path": getPolicyPathSchema(true, false, "Tier-0 gateway path"),
+			"edge_path":    getPolicyPathSchema(false, false, "Policy path of edge node to retrieve status from"),
+			"neighbor_address": {
+				Type:         schema.TypeString,
+				Description:  "Filter status by neighbor address",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"neighbor": {
+				Type:        schema.TypeList,
+				Description: "BGP neighbor status",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"neighbor_address": {
+							Type:        schema.TypeString,
+							Description: "Neighbor IP address",
+							Computed:    true,
+						},
+						"source_address": {
+							Type:        schema.TypeString,
+							Description: "Source IP address of the BGP session",
+							Computed:    true,
+						},
+						"edge_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the edge node",
+							Computed:    true,
+						},
+						"remote_as_number": {
+							Type:        schema.TypeString,
+							Description: "Remote AS number",
+							Computed:    true,
+						},
+						"connection_state": {
+							Type:        schema.TypeString,
+							Description: "Current state of the BGP session",
+							Computed:    true,
+						},
+						"time_since_established": {
+							Type:        schema.TypeInt,
+							Description: "Time in milliseconds since session was established",
+							Computed:    true,
+						},
+						"received_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Total number of prefixes received from the neighbor",
+							Computed:    true,
+						},
+						"advertised_prefix_count": {
+							Type:        schema.TypeInt,
+							Description: "Total number of prefixes advertised to the neighbor",
+							Computed:    true,
+						},
+						"address_family": {
+							Type:        schema.TypeList,
+							Description: "Prefix counts per address family",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Address family type",
+										Computed:    true,
+									},
+									"received_prefix_count": {
+										Type:        schema.TypeInt,
+										Description: "Number of prefixes received",
+										Computed:    true,
+									},
+									"advertised_prefix_count": {
+										Type:        schema.TypeInt,
+										Description: "Number of prefixes advertised",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyBgpNeighborStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 || gwID == "" {
+		return fmt.Errorf("BGP neighbor status is only available for Tier-0 gateway, got %s", gwPath)
+	}
+
+	sitePath := getPolicyEnforcementPointPath(m)
+	localeServiceID, err := findTier0LocaleServiceForSite(getSessionContext(d, m), connector, gwID, sitePath)
+	if err != nil {
+		return err
+	}
+
+	var edgePath *string
+	if v := d.Get("edge_path").(string); v != "" {
+		edgePath = &v
+	}
+	neighborAddress := d.Get("neighbor_address").(string)
+
+	client := neighbors.NewStatusClient(connector)
+	var statuses []model.PolicyBgpNeighborStatus
+	var cursor *string
+	total := int64(0)
+	for {
+		result, err := client.List(gwID, localeServiceID, cursor, edgePath, &sitePath, nil, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return handleDataSourceReadError(d, "BGP Neighbor Status", gwID, err)
+		}
+		cursor = result.Cursor
+		statuses = append(statuses, result.Results...)
+		if total == 0 && result.ResultCount != nil {
+			// first response
+			total = *result.ResultCount
+		}
+		if cursor == nil || int64(len(statuses)) >= total {
+			break
+		}
+	}
+
+	var neighborList []map[string]interface{}
+	for _, status := range statuses {
+		if neighborAddress != "" && (status.NeighborAddress == nil || *status.NeighborAddress != neighborAddress) {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["neighbor_address"] = status.NeighborAddress
+		elem["source_address"] = status.SourceAddress
+		elem["edge_path"] = status.EdgePath
+		elem["remote_as_number"] = status.RemoteAsNumber
+		elem["connection_state"] = status.ConnectionState
+		elem["time_since_established"] = status.TimeSinceEstablished
+		elem["received_prefix_count"] = status.TotalInPrefixCount
+		elem["advertised_prefix_count"] = status.TotalOutPrefixCount
+
+		var familyList []map[string]interface{}
+		for _, family := range status.AddressFamilies {
+			familyElem := make(map[string]interface{})
+			familyElem["type"] = family.Type_
+			familyElem["received_prefix_count"] = family.InPrefixCount
+			familyElem["advertised_prefix_count"] = family.OutPrefixCount
+			familyList = append(familyList, familyElem)
+		}
+		elem["address_family"] = familyList
+		neighborList = append(neighborList, elem)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("neighbor", neighborList)
+}
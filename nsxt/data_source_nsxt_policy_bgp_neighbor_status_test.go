@@ -0,0 +1,39 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyBgpNeighborStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_bgp_neighbor_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyBgpNeighborStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "neighbor.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyBgpNeighborStatusTemplate() string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + `
+data "nsxt_policy_bgp_neighbor_status" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+}`
+}
@@ -0,0 +1,49 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGatewayForwardingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewayForwardingTableRead,
+
+		Schema: getPolicyGatewayRouteTableSchema("Tier-0 or Tier-1 gateway path"),
+	}
+}
+
+func dataSourceNsxtPolicyGatewayForwardingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("Invalid gateway path %s", gwPath)
+	}
+
+	enforcementPointPath, edgePath, networkPrefix := getPolicyGatewayRouteTableFilters(d, m)
+	tables, err := listPolicyGatewayRouteTables(func(cursor *string) (model.RoutingTableListResult, error) {
+		if isT0 {
+			client := tier_0s.NewForwardingTableClient(connector)
+			return client.List(gwID, nil, cursor, nil, edgePath, enforcementPointPath, nil, networkPrefix, nil, nil, nil, nil)
+		}
+		client := tier_1s.NewForwardingTableClient(connector)
+		return client.List(gwID, nil, cursor, nil, edgePath, enforcementPointPath, nil, networkPrefix, nil, nil, nil, nil)
+	})
+	if err != nil {
+		return handleDataSourceReadError(d, "Gateway Forwarding Table", gwID, err)
+	}
+
+	return setPolicyGatewayRouteTableInSchema(d, tables)
+}
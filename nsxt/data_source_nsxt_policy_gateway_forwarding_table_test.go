@@ -0,0 +1,53 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGatewayForwardingTable_tier0(t *testing.T) {
+	testAccDataSourceNsxtPolicyGatewayForwardingTable(t, true)
+}
+
+func TestAccDataSourceNsxtPolicyGatewayForwardingTable_tier1(t *testing.T) {
+	testAccDataSourceNsxtPolicyGatewayForwardingTable(t, false)
+}
+
+func testAccDataSourceNsxtPolicyGatewayForwardingTable(t *testing.T, tier0 bool) {
+	testResourceName := "data.nsxt_policy_gateway_forwarding_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayForwardingTableTemplate(tier0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "edge_node.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayForwardingTableTemplate(tier0 bool) string {
+	tier := "1"
+	gwTemplate := testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false, false)
+	if tier0 {
+		tier = "0"
+		gwTemplate = testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false)
+	}
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + gwTemplate + fmt.Sprintf(`
+data "nsxt_policy_gateway_forwarding_table" "test" {
+  gateway_path = nsxt_policy_tier%s_gateway.test.path
+}`, tier)
+}
@@ -0,0 +1,178 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyGatewayRoutingTable() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGatewayRoutingTableRead,
+
+		Schema: getPolicyGatewayRouteTableSchema("Tier-0 gateway path"),
+	}
+}
+
+func getPolicyGatewayRouteTableSchema(gatewayDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id":           getDataSourceIDSchema(),
+		"gateway_path": getPolicyPathSchema(true, false, gatewayDescription),
+		"edge_path":    getPolicyPathSchema(false, false, "Policy path of edge node to retrieve routes from"),
+		"network_prefix": {
+			Type:         schema.TypeString,
+			Description:  "Filter routes by network prefix",
+			Optional:     true,
+			ValidateFunc: validateIPCidr(),
+		},
+		"edge_node": {
+			Type:        schema.TypeList,
+			Description: "Routes per edge node",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"edge_path": {
+						Type:        schema.TypeString,
+						Description: "Policy path of the edge node",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Status of the route table on the edge node",
+						Computed:    true,
+					},
+					"error_message": {
+						Type:        schema.TypeString,
+						Description: "Error message in case route table could not be retrieved",
+						Computed:    true,
+					},
+					"route": {
+						Type:        schema.TypeList,
+						Description: "Route entries",
+						Computed:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"network": {
+									Type:        schema.TypeString,
+									Description: "Network CIDR",
+									Computed:    true,
+								},
+								"next_hop": {
+									Type:        schema.TypeString,
+									Description: "Next hop address",
+									Computed:    true,
+								},
+								"admin_distance": {
+									Type:        schema.TypeInt,
+									Description: "Admin distance",
+									Computed:    true,
+								},
+								"route_type": {
+									Type:        schema.TypeString,
+									Description: "Route type",
+									Computed:    true,
+								},
+								"component_type": {
+									Type:        schema.TypeString,
+									Description: "Logical router component type (SR or DR)",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func setPolicyGatewayRouteTableInSchema(d *schema.ResourceData, tables []model.RoutingTable) error {
+	var edgeList []map[string]interface{}
+	for _, table := range tables {
+		elem := make(map[string]interface{})
+		elem["edge_path"] = table.EdgeNode
+		elem["status"] = table.Status
+		elem["error_message"] = table.ErrorMessage
+
+		var routeList []map[string]interface{}
+		for _, entry := range table.RouteEntries {
+			route := make(map[string]interface{})
+			route["network"] = entry.Network
+			route["next_hop"] = entry.NextHop
+			route["admin_distance"] = entry.AdminDistance
+			route["route_type"] = entry.RouteType
+			route["component_type"] = entry.LrComponentType
+			routeList = append(routeList, route)
+		}
+		elem["route"] = routeList
+		edgeList = append(edgeList, elem)
+	}
+
+	d.SetId(newUUID())
+	return d.Set("edge_node", edgeList)
+}
+
+func getPolicyGatewayRouteTableFilters(d *schema.ResourceData, m interface{}) (*string, *string, *string) {
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	var edgePath *string
+	var networkPrefix *string
+	if v := d.Get("edge_path").(string); v != "" {
+		edgePath = &v
+	}
+	if v := d.Get("network_prefix").(string); v != "" {
+		networkPrefix = &v
+	}
+
+	return &enforcementPointPath, edgePath, networkPrefix
+}
+
+func listPolicyGatewayRouteTables(listFunc func(*string) (model.RoutingTableListResult, error)) ([]model.RoutingTable, error) {
+	var results []model.RoutingTable
+	var cursor *string
+	total := int64(0)
+
+	for {
+		listResponse, err := listFunc(cursor)
+		if err != nil {
+			return results, err
+		}
+		cursor = listResponse.Cursor
+		results = append(results, listResponse.Results...)
+		if total == 0 && listResponse.ResultCount != nil {
+			// first response
+			total = *listResponse.ResultCount
+		}
+		if cursor == nil || int64(len(results)) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyGatewayRoutingTableRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if !isT0 || gwID == "" {
+		return fmt.Errorf("Routing table is only available for Tier-0 gateway, got %s", gwPath)
+	}
+
+	enforcementPointPath, edgePath, networkPrefix := getPolicyGatewayRouteTableFilters(d, m)
+	client := tier_0s.NewRoutingTableClient(connector)
+	tables, err := listPolicyGatewayRouteTables(func(cursor *string) (model.RoutingTableListResult, error) {
+		return client.List(gwID, nil, cursor, nil, edgePath, enforcementPointPath, nil, networkPrefix, nil, nil, nil, nil)
+	})
+	if err != nil {
+		return handleDataSourceReadError(d, "Gateway Routing Table", gwID, err)
+	}
+
+	return setPolicyGatewayRouteTableInSchema(d, tables)
+}
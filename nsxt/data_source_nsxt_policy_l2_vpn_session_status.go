@@ -0,0 +1,143 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t0_l2vpn_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/l2vpn_services/sessions"
+	t0_l2vpn_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services/l2vpn_services/sessions"
+	t1_l2vpn_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/l2vpn_services/sessions"
+	t1_l2vpn_nested_sessions "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services/l2vpn_services/sessions"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyL2VPNSessionStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyL2VPNSessionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"session_path": getPolicyPathSchema(true, false, "Policy path of the L2 VPN session"),
+			"runtime_status": {
+				Type:        schema.TypeString,
+				Description: "Runtime status of the L2 VPN session",
+				Computed:    true,
+			},
+			"transport_tunnel": {
+				Type:        schema.TypeList,
+				Description: "Status of transport tunnels for the session",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the transport tunnel",
+							Computed:    true,
+						},
+						"runtime_status": {
+							Type:        schema.TypeString,
+							Description: "Runtime status of the transport tunnel",
+							Computed:    true,
+						},
+						"total_tunnels": {
+							Type:        schema.TypeInt,
+							Description: "Total number of tunnels",
+							Computed:    true,
+						},
+						"negotiated_tunnels": {
+							Type:        schema.TypeInt,
+							Description: "Number of negotiated tunnels",
+							Computed:    true,
+						},
+						"failed_tunnels": {
+							Type:        schema.TypeInt,
+							Description: "Number of failed tunnels",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyL2VPNSessionStatus(connector client.Connector, sessionPath string, enforcementPointPath string) (model.AggregateL2VPNSessionStatus, error) {
+	var obj model.AggregateL2VPNSessionStatus
+	// Path should be like <service path>/sessions/aaa
+	idx := strings.LastIndex(sessionPath, "/sessions/")
+	if idx < 0 {
+		return obj, fmt.Errorf("Invalid L2 VPN session path %s", sessionPath)
+	}
+	isT0, gwID, localeServiceID, serviceID, err := parseL2VPNServicePolicyPath(sessionPath[:idx])
+	if err != nil {
+		return obj, err
+	}
+	sessionID := getPolicyIDFromPath(sessionPath)
+
+	if isT0 {
+		if localeServiceID == "" {
+			client := t0_l2vpn_sessions.NewDetailedStatusClient(connector)
+			return client.Get(gwID, serviceID, sessionID, &enforcementPointPath, nil)
+		}
+		client := t0_l2vpn_nested_sessions.NewDetailedStatusClient(connector)
+		return client.Get(gwID, localeServiceID, serviceID, sessionID, &enforcementPointPath, nil)
+	}
+	if localeServiceID == "" {
+		client := t1_l2vpn_sessions.NewDetailedStatusClient(connector)
+		return client.Get(gwID, serviceID, sessionID, &enforcementPointPath, nil)
+	}
+	client := t1_l2vpn_nested_sessions.NewDetailedStatusClient(connector)
+	return client.Get(gwID, localeServiceID, serviceID, sessionID, &enforcementPointPath, nil)
+}
+
+func dataSourceNsxtPolicyL2VPNSessionStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	sessionPath := d.Get("session_path").(string)
+	status, err := getPolicyL2VPNSessionStatus(connector, sessionPath, getPolicyEnforcementPointPath(m))
+	if err != nil {
+		return handleDataSourceReadError(d, "L2 VPN Session Status", sessionPath, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	// Single enforcement point is expected on local manager
+	if len(status.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(status.Results[0], model.L2VPNSessionStatusNsxtBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		sessionStatus := dataValue.(model.L2VPNSessionStatusNsxt)
+		d.Set("runtime_status", sessionStatus.RuntimeStatus)
+
+		var tunnelList []map[string]interface{}
+		for _, tunnel := range sessionStatus.TransportTunnels {
+			tunnelValue, errors := converter.ConvertToGolang(tunnel, model.IPSecVpnTransportStatusBindingType())
+			if len(errors) > 0 {
+				return errors[0]
+			}
+			tunnelStatus := tunnelValue.(model.IPSecVpnTransportStatus)
+			elem := make(map[string]interface{})
+			elem["path"] = tunnelStatus.TransportTunnelPath
+			if tunnelStatus.SessionStatus != nil {
+				elem["runtime_status"] = tunnelStatus.SessionStatus.RuntimeStatus
+				elem["total_tunnels"] = tunnelStatus.SessionStatus.TotalTunnels
+				elem["negotiated_tunnels"] = tunnelStatus.SessionStatus.NegotiatedTunnels
+				elem["failed_tunnels"] = tunnelStatus.SessionStatus.FailedTunnels
+			}
+			tunnelList = append(tunnelList, elem)
+		}
+		d.Set("transport_tunnel", tunnelList)
+	}
+
+	d.SetId(sessionPath)
+	return nil
+}
@@ -0,0 +1,35 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyL2VpnSessionStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_l2_vpn_session_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.2.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL2VpnSessionStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "runtime_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyL2VpnSessionStatusTemplate() string {
+	return testAccNsxtPolicyL2VpnSessionMinimalistic(false) + `
+data "nsxt_policy_l2_vpn_session_status" "test" {
+  session_path = nsxt_policy_l2_vpn_session.test.path
+}`
+}
@@ -0,0 +1,112 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBPoolStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBPoolStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":              getDataSourceIDSchema(),
+			"pool_path":       getPolicyPathSchema(true, false, "Policy path of the LB pool"),
+			"lb_service_path": getPolicyPathSchema(true, false, "Policy path of the LB service the pool is consumed by"),
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the pool",
+				Computed:    true,
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Description: "Status of pool members",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Description: "Pool member IP address",
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeString,
+							Description: "Pool member port",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Pool member status",
+							Computed:    true,
+						},
+						"failure_cause": {
+							Type:        schema.TypeString,
+							Description: "The cause of pool member health check failure",
+							Computed:    true,
+						},
+						"last_check_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp in milliseconds since epoch of last health check",
+							Computed:    true,
+						},
+						"last_state_change_time": {
+							Type:        schema.TypeInt,
+							Description: "Timestamp in milliseconds since epoch when pool member status changed",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLBPoolStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	poolPath := d.Get("pool_path").(string)
+	poolID := getPolicyIDFromPath(poolPath)
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	client := lb_pools.NewDetailedStatusClient(connector)
+	status, err := client.Get(serviceID, poolID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Pool Status", poolPath, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	// Single enforcement point is expected on local manager
+	if len(status.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(status.Results[0], model.LBPoolStatusBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		poolStatus := dataValue.(model.LBPoolStatus)
+		d.Set("status", poolStatus.Status)
+
+		var memberList []map[string]interface{}
+		for _, member := range poolStatus.Members {
+			elem := make(map[string]interface{})
+			elem["ip_address"] = member.IpAddress
+			elem["port"] = member.Port
+			elem["status"] = member.Status
+			elem["failure_cause"] = member.FailureCause
+			elem["last_check_time"] = member.LastCheckTime
+			elem["last_state_change_time"] = member.LastStateChangeTime
+			memberList = append(memberList, elem)
+		}
+		d.Set("member", memberList)
+	}
+
+	d.SetId(poolPath)
+	return nil
+}
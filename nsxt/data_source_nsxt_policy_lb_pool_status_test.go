@@ -0,0 +1,39 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBPoolStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_pool_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "10.10.10.1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "member.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPoolStatusTemplate() string {
+	return testAccNsxtPolicyLBStatusVirtualServerTemplate() + `
+data "nsxt_policy_lb_pool_status" "test" {
+  pool_path       = nsxt_policy_lb_pool.test.path
+  lb_service_path = nsxt_policy_lb_service.test.path
+}`
+}
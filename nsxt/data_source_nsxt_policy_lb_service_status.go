@@ -0,0 +1,210 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBServiceStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBServiceStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":              getDataSourceIDSchema(),
+			"lb_service_path": getPolicyPathSchema(true, false, "Policy path of the LB service"),
+			"service_status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the LB service",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "Error message, if available",
+				Computed:    true,
+			},
+			"cpu_usage": {
+				Type:        schema.TypeInt,
+				Description: "CPU usage of the LB service in percent",
+				Computed:    true,
+			},
+			"memory_usage": {
+				Type:        schema.TypeInt,
+				Description: "Memory usage of the LB service in percent",
+				Computed:    true,
+			},
+			"active_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "Ids of load balancer service related active transport nodes",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"standby_transport_nodes": {
+				Type:        schema.TypeList,
+				Description: "Ids of load balancer service related standby transport nodes",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"instance_detail": {
+				Type:        schema.TypeList,
+				Description: "Status of load balancer instance per transport node",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"transport_node_id": {
+							Type:        schema.TypeString,
+							Description: "Id of the transport node",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of load balancer instances on this transport node",
+							Computed:    true,
+						},
+						"instance_number": {
+							Type:        schema.TypeInt,
+							Description: "Number of load balancer instances in this status",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"usage": {
+				Type:        schema.TypeList,
+				Description: "Capacity and usage of the LB service",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_size": {
+							Type:        schema.TypeString,
+							Description: "Size of the LB service",
+							Computed:    true,
+						},
+						"usage_percentage": {
+							Type:        schema.TypeFloat,
+							Description: "Maximum usage percentage among virtual servers, pools and pool members",
+							Computed:    true,
+						},
+						"severity": {
+							Type:        schema.TypeString,
+							Description: "Severity calculated from usage percentage",
+							Computed:    true,
+						},
+						"current_virtual_server_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of virtual servers configured on the service",
+							Computed:    true,
+						},
+						"virtual_server_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Virtual server capacity of the service",
+							Computed:    true,
+						},
+						"current_pool_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of pools configured on the service",
+							Computed:    true,
+						},
+						"pool_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Pool capacity of the service",
+							Computed:    true,
+						},
+						"current_pool_member_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of pool members configured on the service",
+							Computed:    true,
+						},
+						"pool_member_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Pool member capacity of the service",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyLBServiceStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	servicePath := d.Get("lb_service_path").(string)
+	serviceID := getPolicyIDFromPath(servicePath)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	includeInstanceDetails := true
+	statusClient := lb_services.NewDetailedStatusClient(connector)
+	status, err := statusClient.Get(serviceID, &enforcementPointPath, &includeInstanceDetails, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Service Status", servicePath, err)
+	}
+
+	// Single enforcement point is expected on local manager
+	if len(status.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(status.Results[0], model.LBServiceStatusBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		serviceStatus := dataValue.(model.LBServiceStatus)
+		d.Set("service_status", serviceStatus.ServiceStatus)
+		d.Set("error_message", serviceStatus.ErrorMessage)
+		d.Set("cpu_usage", serviceStatus.CpuUsage)
+		d.Set("memory_usage", serviceStatus.MemoryUsage)
+		d.Set("active_transport_nodes", serviceStatus.ActiveTransportNodes)
+		d.Set("standby_transport_nodes", serviceStatus.StandbyTransportNodes)
+
+		var detailList []map[string]interface{}
+		for _, perNode := range serviceStatus.InstanceDetailPerTn {
+			for _, perStatus := range perNode.InstanceDetailPerStatus {
+				elem := make(map[string]interface{})
+				elem["transport_node_id"] = perNode.TransportNodeId
+				elem["status"] = perStatus.Status
+				elem["instance_number"] = perStatus.InstanceNumber
+				detailList = append(detailList, elem)
+			}
+		}
+		d.Set("instance_detail", detailList)
+	}
+
+	usageClient := lb_services.NewServiceUsageClient(connector)
+	usage, err := usageClient.Get(serviceID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Service Usage", servicePath, err)
+	}
+
+	if len(usage.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(usage.Results[0], model.LBServiceUsageBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		serviceUsage := dataValue.(model.LBServiceUsage)
+		elem := make(map[string]interface{})
+		elem["service_size"] = serviceUsage.ServiceSize
+		elem["usage_percentage"] = serviceUsage.UsagePercentage
+		elem["severity"] = serviceUsage.Severity
+		elem["current_virtual_server_count"] = serviceUsage.CurrentVirtualServerCount
+		elem["virtual_server_capacity"] = serviceUsage.VirtualServerCapacity
+		elem["current_pool_count"] = serviceUsage.CurrentPoolCount
+		elem["pool_capacity"] = serviceUsage.PoolCapacity
+		elem["current_pool_member_count"] = serviceUsage.CurrentPoolMemberCount
+		elem["pool_member_capacity"] = serviceUsage.PoolMemberCapacity
+		d.Set("usage", []interface{}{elem})
+	}
+
+	d.SetId(servicePath)
+	return nil
+}
@@ -0,0 +1,37 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBServiceStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_service_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBServiceStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "service_status"),
+					resource.TestCheckResourceAttr(testResourceName, "usage.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "usage.0.service_size", "SMALL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBServiceStatusTemplate() string {
+	return testAccNsxtPolicyLBServiceTemplate(true) + `
+data "nsxt_policy_lb_service_status" "test" {
+  lb_service_path = nsxt_policy_lb_service.test.path
+}`
+}
@@ -0,0 +1,198 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_virtual_servers"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLBVirtualServerStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyLBVirtualServerStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                  getDataSourceIDSchema(),
+			"virtual_server_path": getPolicyPathSchema(true, false, "Policy path of the LB virtual server"),
+			"lb_service_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the LB service. If not specified, service configured on the virtual server is used",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Operational status of the virtual server",
+				Computed:    true,
+			},
+			"statistics": {
+				Type:        schema.TypeList,
+				Description: "Statistics counters of the virtual server",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bytes_in": {
+							Type:        schema.TypeInt,
+							Description: "Number of bytes in",
+							Computed:    true,
+						},
+						"bytes_out": {
+							Type:        schema.TypeInt,
+							Description: "Number of bytes out",
+							Computed:    true,
+						},
+						"packets_in": {
+							Type:        schema.TypeInt,
+							Description: "Number of packets in",
+							Computed:    true,
+						},
+						"packets_out": {
+							Type:        schema.TypeInt,
+							Description: "Number of packets out",
+							Computed:    true,
+						},
+						"current_sessions": {
+							Type:        schema.TypeInt,
+							Description: "Number of current sessions",
+							Computed:    true,
+						},
+						"max_sessions": {
+							Type:        schema.TypeInt,
+							Description: "Number of maximum sessions",
+							Computed:    true,
+						},
+						"total_sessions": {
+							Type:        schema.TypeInt,
+							Description: "Number of total sessions",
+							Computed:    true,
+						},
+						"current_session_rate": {
+							Type:        schema.TypeFloat,
+							Description: "Current session rate",
+							Computed:    true,
+						},
+						"http_requests": {
+							Type:        schema.TypeInt,
+							Description: "Number of HTTP requests",
+							Computed:    true,
+						},
+						"http_request_rate": {
+							Type:        schema.TypeFloat,
+							Description: "HTTP request rate",
+							Computed:    true,
+						},
+						"dropped_packets_by_access_list": {
+							Type:        schema.TypeInt,
+							Description: "Number of packets dropped by access list",
+							Computed:    true,
+						},
+						"dropped_sessions_by_lbrule_action": {
+							Type:        schema.TypeInt,
+							Description: "Number of sessions dropped by LB rule action",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLBServicePathForVirtualServer(connector client.Connector, virtualServerID string) (string, error) {
+	client := infra.NewLbVirtualServersClient(connector)
+	obj, err := client.Get(virtualServerID)
+	if err != nil {
+		return "", err
+	}
+	if obj.LbServicePath == nil || *obj.LbServicePath == "" {
+		return "", fmt.Errorf("LB virtual server %s is not attached to LB service", virtualServerID)
+	}
+
+	return *obj.LbServicePath, nil
+}
+
+func setPolicyLBStatisticsCounterInSchema(d *schema.ResourceData, counter *model.LBStatisticsCounter) {
+	if counter == nil {
+		return
+	}
+
+	elem := make(map[string]interface{})
+	elem["bytes_in"] = counter.BytesIn
+	elem["bytes_out"] = counter.BytesOut
+	elem["packets_in"] = counter.PacketsIn
+	elem["packets_out"] = counter.PacketsOut
+	elem["current_sessions"] = counter.CurrentSessions
+	elem["max_sessions"] = counter.MaxSessions
+	elem["total_sessions"] = counter.TotalSessions
+	elem["current_session_rate"] = counter.CurrentSessionRate
+	elem["http_requests"] = counter.HttpRequests
+	elem["http_request_rate"] = counter.HttpRequestRate
+	elem["dropped_packets_by_access_list"] = counter.DroppedPacketsByAccessList
+	elem["dropped_sessions_by_lbrule_action"] = counter.DroppedSessionsByLbruleAction
+	d.Set("statistics", []interface{}{elem})
+}
+
+func dataSourceNsxtPolicyLBVirtualServerStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	virtualServerPath := d.Get("virtual_server_path").(string)
+	virtualServerID := getPolicyIDFromPath(virtualServerPath)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	servicePath := d.Get("lb_service_path").(string)
+	if servicePath == "" {
+		var err error
+		servicePath, err = getPolicyLBServicePathForVirtualServer(connector, virtualServerID)
+		if err != nil {
+			return handleDataSourceReadError(d, "LB Virtual Server", virtualServerPath, err)
+		}
+	}
+	serviceID := getPolicyIDFromPath(servicePath)
+
+	statusClient := lb_virtual_servers.NewDetailedStatusClient(connector)
+	status, err := statusClient.Get(serviceID, virtualServerID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Virtual Server Status", virtualServerPath, err)
+	}
+
+	// Single enforcement point is expected on local manager
+	if len(status.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(status.Results[0], model.LBVirtualServerStatusBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		virtualServerStatus := dataValue.(model.LBVirtualServerStatus)
+		d.Set("status", virtualServerStatus.Status)
+	}
+
+	statisticsClient := lb_virtual_servers.NewStatisticsClient(connector)
+	statistics, err := statisticsClient.Get(serviceID, virtualServerID, &enforcementPointPath, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LB Virtual Server Statistics", virtualServerPath, err)
+	}
+
+	if len(statistics.Results) > 0 {
+		dataValue, errors := converter.ConvertToGolang(statistics.Results[0], model.LBVirtualServerStatisticsBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		virtualServerStatistics := dataValue.(model.LBVirtualServerStatistics)
+		setPolicyLBStatisticsCounterInSchema(d, virtualServerStatistics.Statistics)
+	}
+
+	d.Set("lb_service_path", servicePath)
+	d.SetId(virtualServerPath)
+	return nil
+}
@@ -0,0 +1,62 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBVirtualServerStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_virtual_server_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerStatusTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttrPair(testResourceName, "lb_service_path", "nsxt_policy_lb_service.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "statistics.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBStatusVirtualServerTemplate() string {
+	return testAccNsxtPolicyLBServiceTemplate(true) + `
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type         = "TCP"
+  display_name = "default-tcp-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name = "terraform-status-test-pool"
+  member {
+    ip_address = "10.10.10.1"
+    port       = "80"
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "terraform-status-test-vs"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  ip_address               = "10.10.20.1"
+  ports                    = ["80"]
+  pool_path                = nsxt_policy_lb_pool.test.path
+  service_path             = nsxt_policy_lb_service.test.path
+}`
+}
+
+func testAccNsxtPolicyLBVirtualServerStatusTemplate() string {
+	return testAccNsxtPolicyLBStatusVirtualServerTemplate() + `
+data "nsxt_policy_lb_virtual_server_status" "test" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+}`
+}
@@ -0,0 +1,58 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicySegmentPortRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"segment_path": getPolicyPathSchema(false, false, "Path of parent segment"),
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Description: "VIF attachment ID of the port",
+				Computed:    true,
+			},
+			"context": getContextSchema(),
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	segmentPath := d.Get("segment_path").(string)
+	query := make(map[string]string)
+	if len(segmentPath) > 0 {
+		query["parent_path"] = segmentPath
+	}
+	obj, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "SegmentPort", query)
+	if err != nil {
+		return err
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToGolang(obj, model.SegmentPortBindingType())
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	port := dataValue.(model.SegmentPort)
+
+	d.Set("segment_path", port.ParentPath)
+	if port.Attachment != nil {
+		d.Set("attachment_id", port.Attachment.Id)
+	}
+
+	return nil
+}
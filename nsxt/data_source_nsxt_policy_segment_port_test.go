@@ -0,0 +1,46 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_segment_port.test"
+	tzName := getOverlayTransportZoneName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortReadTemplate(tzName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", "nsxt_policy_segment_port.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "segment_path", "nsxt_policy_segment.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortReadTemplate(tzName string, name string) string {
+	return testAccNsxtPolicySegmentPortMinimalTemplate(tzName, name) + fmt.Sprintf(`
+
+data "nsxt_policy_segment_port" "test" {
+  display_name = "%s"
+  segment_path = nsxt_policy_segment.test.path
+
+  depends_on = [nsxt_policy_segment_port.test]
+}`, name)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	nsx_policy_sdk "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
 	global_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm"
 	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
 	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
@@ -229,6 +230,69 @@ func getGatewayIntersiteConfigSchema() *schema.Schema {
 	}
 }
 
+func getPolicyWaitForRealizationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for realization on edge nodes and fail apply on realization error",
+		Optional:    true,
+	}
+}
+
+func getPolicyRealizationTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Realization timeout in seconds, applicable when wait_for_realization is set",
+		Optional:     true,
+		Default:      600,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// Alarms are most descriptive, hence runtime and publish errors are only reported
+// if no alarms are present
+func getPolicyRealizationErrorMessage(obj model.GenericPolicyRealizedResource) string {
+	var messages []string
+	for _, alarm := range obj.Alarms {
+		if alarm.Message != nil {
+			messages = append(messages, *alarm.Message)
+		}
+	}
+	if len(messages) == 0 && obj.RuntimeError != nil {
+		messages = append(messages, *obj.RuntimeError)
+	}
+	if len(messages) == 0 && obj.PublishStatusError != nil {
+		messages = append(messages, *obj.PublishStatusError)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func policyGatewayWaitForRealization(d *schema.ResourceData, m interface{}, resourceType string, id string) error {
+	if !d.Get("wait_for_realization").(bool) {
+		return nil
+	}
+
+	if getSessionContext(d, m).ClientType != utl.Local {
+		log.Printf("[WARNING] Waiting for realization of %s %s is only supported on local manager", resourceType, id)
+		return nil
+	}
+
+	timeout := d.Get("realization_timeout").(int)
+
+	log.Printf("[DEBUG] Waiting for realization of %s with ID %s", resourceType, id)
+	stateConf := nsxtPolicyWaitForRealizationStateConf(getPolicyConnector(m), d, d.Get("path").(string), timeout)
+	entity, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to wait for realization of %s %s: %v", resourceType, id, err)
+	}
+
+	realizedResource := entity.(model.GenericPolicyRealizedResource)
+	if realizedResource.State != nil && *realizedResource.State == "ERROR" {
+		return fmt.Errorf("Realization of %s %s failed: %s", resourceType, id, getPolicyRealizationErrorMessage(realizedResource))
+	}
+
+	return nil
+}
+
 func listPolicyGatewayLocaleServices(context utl.SessionContext, connector client.Connector, gwID string, listLocaleServicesFunc func(utl.SessionContext, client.Connector, string, *string) (model.LocaleServicesListResult, error)) ([]model.LocaleServices, error) {
 	var results []model.LocaleServices
 	var cursor *string
@@ -437,10 +501,53 @@ func policyInfraPatch(context utl.SessionContext, obj model.Infra, connector cli
 		return infraClient.Patch(gmObj.(gm_model.Infra), &enforceRevision)
 	}
 
+	if context.ClientType == utl.VPC {
+		return policyVPCInfraPatch(context, obj.Children, connector, enforceRevision)
+	}
+
 	infraClient := nsx_policy.NewInfraClient(context, connector)
 	return infraClient.Patch(obj, &enforceRevision)
 }
 
+// VPC objects are not part of the Infra tree, hence H-API calls for VPC need to
+// wrap children with org, project and VPC references under OrgRoot
+func policyVPCInfraPatch(context utl.SessionContext, vpcChildren []*data.StructValue, connector client.Connector, enforceRevision bool) error {
+	converter := bindings.NewTypeConverter()
+	children := vpcChildren
+	references := []struct {
+		id         string
+		targetType string
+	}{
+		{context.VPCID, "Vpc"},
+		{context.ProjectID, "Project"},
+		{utl.DefaultOrgID, "Org"},
+	}
+	for _, ref := range references {
+		id := ref.id
+		targetType := ref.targetType
+		childRef := model.ChildResourceReference{
+			Id:           &id,
+			ResourceType: "ChildResourceReference",
+			TargetType:   &targetType,
+			Children:     children,
+		}
+		dataValue, errors := converter.ConvertToVapi(childRef, model.ChildResourceReferenceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		children = []*data.StructValue{dataValue.(*data.StructValue)}
+	}
+
+	orgRootType := "OrgRoot"
+	orgRoot := model.OrgRoot{
+		Children:     children,
+		ResourceType: &orgRootType,
+	}
+
+	orgRootClient := nsx_policy_sdk.NewOrgRootClient(connector)
+	return orgRootClient.Patch(orgRoot, &enforceRevision)
+}
+
 func getRedistributionConfigRuleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
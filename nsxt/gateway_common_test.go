@@ -0,0 +1,62 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestGetPolicyRealizationErrorMessage(t *testing.T) {
+	alarm1 := "Edge cluster is not configured"
+	alarm2 := "Interface IP conflicts with existing subnet"
+	runtimeError := "Runtime error"
+	publishError := "Publish error"
+
+	tests := []struct {
+		name     string
+		obj      model.GenericPolicyRealizedResource
+		expected string
+	}{
+		{
+			name:     "no errors",
+			obj:      model.GenericPolicyRealizedResource{},
+			expected: "",
+		},
+		{
+			name: "alarms take precedence",
+			obj: model.GenericPolicyRealizedResource{
+				Alarms:             []model.PolicyAlarmResource{{Message: &alarm1}, {}, {Message: &alarm2}},
+				RuntimeError:       &runtimeError,
+				PublishStatusError: &publishError,
+			},
+			expected: alarm1 + "; " + alarm2,
+		},
+		{
+			name: "runtime error without alarms",
+			obj: model.GenericPolicyRealizedResource{
+				Alarms:             []model.PolicyAlarmResource{{}},
+				RuntimeError:       &runtimeError,
+				PublishStatusError: &publishError,
+			},
+			expected: runtimeError,
+		},
+		{
+			name: "publish error only",
+			obj: model.GenericPolicyRealizedResource{
+				PublishStatusError: &publishError,
+			},
+			expected: publishError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if message := getPolicyRealizationErrorMessage(test.obj); message != test.expected {
+				t.Errorf("Expected realization error message %q, got %q", test.expected, message)
+			}
+		})
+	}
+}
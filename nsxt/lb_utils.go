@@ -300,6 +300,63 @@ func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interfac
 	return nil
 }
 
+func resourceNsxtPolicyLBPersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+	msg := fmt.Sprintf("Error retrieving resource LBPersistenceProfile")
+	return false, logAPIError(msg, err)
+}
+
+func resourceNsxtPolicyLBPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPersistenceProfile ID")
+	}
+	connector := getPolicyConnector(m)
+	forceParam := true
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	err := client.Delete(id, &forceParam)
+	if err != nil {
+		return handleDeleteError("LBPersistenceProfile", id, err)
+	}
+	return nil
+}
+
+func getLbPersistenceSharedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether persistence entries are shared among virtual servers referencing this profile",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func getLbPersistenceTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Persistence expiration time in seconds, counted from the time all the connections are completed",
+		Optional:     true,
+		Default:      300,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func getLbHaPersistenceMirroringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether persistence entries are synchronized to the HA peer",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
 func getLbServerSslSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -142,6 +142,13 @@ func isNotFoundError(err error) bool {
 	return false
 }
 
+func isConcurrentChangeError(err error) bool {
+	if _, ok := err.(errors.ConcurrentChange); ok {
+		return true
+	}
+	return false
+}
+
 func isServiceUnavailableError(err error) bool {
 	if _, ok := err.(errors.ServiceUnavailable); ok {
 		return true
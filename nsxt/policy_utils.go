@@ -249,6 +249,9 @@ func nsxtPolicyPathResourceImporterHelper(d *schema.ResourceData, m interface{})
 			contexts := make([]interface{}, 1)
 			ctxMap := make(map[string]interface{})
 			ctxMap["project_id"] = pathSegs[4]
+			if len(pathSegs) > 7 && pathSegs[5] == "vpcs" {
+				ctxMap["vpc_id"] = pathSegs[6]
+			}
 			contexts[0] = ctxMap
 			d.Set("context", contexts)
 			d.SetId(pathSegs[len(pathSegs)-1])
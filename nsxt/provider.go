@@ -293,12 +293,17 @@ func Provider() *schema.Provider {
 			"nsxt_policy_bfd_profile":                   dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile":     dataSourceNsxtPolicyIntrusionServiceProfile(),
 			"nsxt_policy_lb_service":                    dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_lb_service_status":             dataSourceNsxtPolicyLBServiceStatus(),
+			"nsxt_policy_lb_virtual_server_status":      dataSourceNsxtPolicyLBVirtualServerStatus(),
+			"nsxt_policy_lb_pool_status":                dataSourceNsxtPolicyLBPoolStatus(),
 			"nsxt_policy_gateway_locale_service":        dataSourceNsxtPolicyGatewayLocaleService(),
 			"nsxt_policy_bridge_profile":                dataSourceNsxtPolicyBridgeProfile(),
 			"nsxt_policy_ipsec_vpn_local_endpoint":      dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
 			"nsxt_policy_ipsec_vpn_service":             dataSourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                dataSourceNsxtPolicyL2VpnService(),
+			"nsxt_policy_l2_vpn_session_status":         dataSourceNsxtPolicyL2VPNSessionStatus(),
 			"nsxt_policy_segment":                       dataSourceNsxtPolicySegment(),
+			"nsxt_policy_segment_port":                  dataSourceNsxtPolicySegmentPort(),
 			"nsxt_policy_project":                       dataSourceNsxtPolicyProject(),
 			"nsxt_policy_gateway_dns_forwarder":         dataSourceNsxtPolicyGatewayDNSForwarder(),
 			"nsxt_policy_gateway_prefix_list":           dataSourceNsxtPolicyGatewayPrefixList(),
@@ -317,165 +322,207 @@ func Provider() *schema.Provider {
 			"nsxt_edge_upgrade_group":                   dataSourceNsxtEdgeUpgradeGroup(),
 			"nsxt_host_upgrade_group":                   dataSourceNsxtHostUpgradeGroup(),
 			"nsxt_policy_gateway_interface_realization": dataSourceNsxtPolicyGatewayInterfaceRealization(),
+			"nsxt_policy_gateway_routing_table":         dataSourceNsxtPolicyGatewayRoutingTable(),
+			"nsxt_policy_gateway_forwarding_table":      dataSourceNsxtPolicyGatewayForwardingTable(),
+			"nsxt_policy_bgp_neighbor_status":           dataSourceNsxtPolicyBgpNeighborStatus(),
 			"nsxt_upgrade_postcheck":                    dataSourceNsxtUpgradePostCheck(),
 			"nsxt_upgrade_prepare_ready":                dataSourceNsxtUpgradePrepareReady(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"nsxt_dhcp_relay_profile":                      resourceNsxtDhcpRelayProfile(),
-			"nsxt_dhcp_relay_service":                      resourceNsxtDhcpRelayService(),
-			"nsxt_dhcp_server_profile":                     resourceNsxtDhcpServerProfile(),
-			"nsxt_logical_dhcp_server":                     resourceNsxtLogicalDhcpServer(),
-			"nsxt_dhcp_server_ip_pool":                     resourceNsxtDhcpServerIPPool(),
-			"nsxt_logical_switch":                          resourceNsxtLogicalSwitch(),
-			"nsxt_vlan_logical_switch":                     resourceNsxtVlanLogicalSwitch(),
-			"nsxt_logical_dhcp_port":                       resourceNsxtLogicalDhcpPort(),
-			"nsxt_logical_port":                            resourceNsxtLogicalPort(),
-			"nsxt_logical_tier0_router":                    resourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router":                    resourceNsxtLogicalTier1Router(),
-			"nsxt_logical_router_centralized_service_port": resourceNsxtLogicalRouterCentralizedServicePort(),
-			"nsxt_logical_router_downlink_port":            resourceNsxtLogicalRouterDownLinkPort(),
-			"nsxt_logical_router_link_port_on_tier0":       resourceNsxtLogicalRouterLinkPortOnTier0(),
-			"nsxt_logical_router_link_port_on_tier1":       resourceNsxtLogicalRouterLinkPortOnTier1(),
-			"nsxt_ip_discovery_switching_profile":          resourceNsxtIPDiscoverySwitchingProfile(),
-			"nsxt_mac_management_switching_profile":        resourceNsxtMacManagementSwitchingProfile(),
-			"nsxt_qos_switching_profile":                   resourceNsxtQosSwitchingProfile(),
-			"nsxt_spoofguard_switching_profile":            resourceNsxtSpoofGuardSwitchingProfile(),
-			"nsxt_switch_security_switching_profile":       resourceNsxtSwitchSecuritySwitchingProfile(),
-			"nsxt_l4_port_set_ns_service":                  resourceNsxtL4PortSetNsService(),
-			"nsxt_algorithm_type_ns_service":               resourceNsxtAlgorithmTypeNsService(),
-			"nsxt_icmp_type_ns_service":                    resourceNsxtIcmpTypeNsService(),
-			"nsxt_igmp_type_ns_service":                    resourceNsxtIgmpTypeNsService(),
-			"nsxt_ether_type_ns_service":                   resourceNsxtEtherTypeNsService(),
-			"nsxt_ip_protocol_ns_service":                  resourceNsxtIPProtocolNsService(),
-			"nsxt_ns_service_group":                        resourceNsxtNsServiceGroup(),
-			"nsxt_ns_group":                                resourceNsxtNsGroup(),
-			"nsxt_firewall_section":                        resourceNsxtFirewallSection(),
-			"nsxt_nat_rule":                                resourceNsxtNatRule(),
-			"nsxt_ip_block":                                resourceNsxtIPBlock(),
-			"nsxt_ip_block_subnet":                         resourceNsxtIPBlockSubnet(),
-			"nsxt_ip_pool":                                 resourceNsxtIPPool(),
-			"nsxt_ip_pool_allocation_ip_address":           resourceNsxtIPPoolAllocationIPAddress(),
-			"nsxt_ip_set":                                  resourceNsxtIPSet(),
-			"nsxt_static_route":                            resourceNsxtStaticRoute(),
-			"nsxt_vm_tags":                                 resourceNsxtVMTags(),
-			"nsxt_lb_icmp_monitor":                         resourceNsxtLbIcmpMonitor(),
-			"nsxt_lb_tcp_monitor":                          resourceNsxtLbTCPMonitor(),
-			"nsxt_lb_udp_monitor":                          resourceNsxtLbUDPMonitor(),
-			"nsxt_lb_http_monitor":                         resourceNsxtLbHTTPMonitor(),
-			"nsxt_lb_https_monitor":                        resourceNsxtLbHTTPSMonitor(),
-			"nsxt_lb_passive_monitor":                      resourceNsxtLbPassiveMonitor(),
-			"nsxt_lb_pool":                                 resourceNsxtLbPool(),
-			"nsxt_lb_tcp_virtual_server":                   resourceNsxtLbTCPVirtualServer(),
-			"nsxt_lb_udp_virtual_server":                   resourceNsxtLbUDPVirtualServer(),
-			"nsxt_lb_http_virtual_server":                  resourceNsxtLbHTTPVirtualServer(),
-			"nsxt_lb_http_forwarding_rule":                 resourceNsxtLbHTTPForwardingRule(),
-			"nsxt_lb_http_request_rewrite_rule":            resourceNsxtLbHTTPRequestRewriteRule(),
-			"nsxt_lb_http_response_rewrite_rule":           resourceNsxtLbHTTPResponseRewriteRule(),
-			"nsxt_lb_cookie_persistence_profile":           resourceNsxtLbCookiePersistenceProfile(),
-			"nsxt_lb_source_ip_persistence_profile":        resourceNsxtLbSourceIPPersistenceProfile(),
-			"nsxt_lb_client_ssl_profile":                   resourceNsxtLbClientSslProfile(),
-			"nsxt_lb_server_ssl_profile":                   resourceNsxtLbServerSslProfile(),
-			"nsxt_lb_service":                              resourceNsxtLbService(),
-			"nsxt_lb_fast_tcp_application_profile":         resourceNsxtLbFastTCPApplicationProfile(),
-			"nsxt_lb_fast_udp_application_profile":         resourceNsxtLbFastUDPApplicationProfile(),
-			"nsxt_lb_http_application_profile":             resourceNsxtLbHTTPApplicationProfile(),
-			"nsxt_policy_tier1_gateway":                    resourceNsxtPolicyTier1Gateway(),
-			"nsxt_policy_tier1_gateway_interface":          resourceNsxtPolicyTier1GatewayInterface(),
-			"nsxt_policy_tier0_gateway":                    resourceNsxtPolicyTier0Gateway(),
-			"nsxt_policy_tier0_gateway_interface":          resourceNsxtPolicyTier0GatewayInterface(),
-			"nsxt_policy_tier0_gateway_ha_vip_config":      resourceNsxtPolicyTier0GatewayHAVipConfig(),
-			"nsxt_policy_group":                            resourceNsxtPolicyGroup(),
-			"nsxt_policy_domain":                           resourceNsxtPolicyDomain(),
-			"nsxt_policy_security_policy":                  resourceNsxtPolicySecurityPolicy(),
-			"nsxt_policy_service":                          resourceNsxtPolicyService(),
-			"nsxt_policy_gateway_policy":                   resourceNsxtPolicyGatewayPolicy(),
-			"nsxt_policy_predefined_gateway_policy":        resourceNsxtPolicyPredefinedGatewayPolicy(),
-			"nsxt_policy_predefined_security_policy":       resourceNsxtPolicyPredefinedSecurityPolicy(),
-			"nsxt_policy_segment":                          resourceNsxtPolicySegment(),
-			"nsxt_policy_vlan_segment":                     resourceNsxtPolicyVlanSegment(),
-			"nsxt_policy_fixed_segment":                    resourceNsxtPolicyFixedSegment(),
-			"nsxt_policy_static_route":                     resourceNsxtPolicyStaticRoute(),
-			"nsxt_policy_gateway_prefix_list":              resourceNsxtPolicyGatewayPrefixList(),
-			"nsxt_policy_vm_tags":                          resourceNsxtPolicyVMTags(),
-			"nsxt_policy_nat_rule":                         resourceNsxtPolicyNATRule(),
-			"nsxt_policy_ip_block":                         resourceNsxtPolicyIPBlock(),
-			"nsxt_policy_lb_pool":                          resourceNsxtPolicyLBPool(),
-			"nsxt_policy_ip_pool":                          resourceNsxtPolicyIPPool(),
-			"nsxt_policy_ip_pool_block_subnet":             resourceNsxtPolicyIPPoolBlockSubnet(),
-			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
-			"nsxt_policy_lb_service":                       resourceNsxtPolicyLBService(),
-			"nsxt_policy_lb_virtual_server":                resourceNsxtPolicyLBVirtualServer(),
-			"nsxt_policy_ip_address_allocation":            resourceNsxtPolicyIPAddressAllocation(),
-			"nsxt_policy_bgp_neighbor":                     resourceNsxtPolicyBgpNeighbor(),
-			"nsxt_policy_bgp_config":                       resourceNsxtPolicyBgpConfig(),
-			"nsxt_policy_dhcp_relay":                       resourceNsxtPolicyDhcpRelayConfig(),
-			"nsxt_policy_dhcp_server":                      resourceNsxtPolicyDhcpServer(),
-			"nsxt_policy_context_profile":                  resourceNsxtPolicyContextProfile(),
-			"nsxt_policy_dhcp_v4_static_binding":           resourceNsxtPolicyDhcpV4StaticBinding(),
-			"nsxt_policy_dhcp_v6_static_binding":           resourceNsxtPolicyDhcpV6StaticBinding(),
-			"nsxt_policy_dns_forwarder_zone":               resourceNsxtPolicyDNSForwarderZone(),
-			"nsxt_policy_gateway_dns_forwarder":            resourceNsxtPolicyGatewayDNSForwarder(),
-			"nsxt_policy_gateway_community_list":           resourceNsxtPolicyGatewayCommunityList(),
-			"nsxt_policy_gateway_route_map":                resourceNsxtPolicyGatewayRouteMap(),
-			"nsxt_policy_intrusion_service_policy":         resourceNsxtPolicyIntrusionServicePolicy(),
-			"nsxt_policy_static_route_bfd_peer":            resourceNsxtPolicyStaticRouteBfdPeer(),
-			"nsxt_policy_intrusion_service_profile":        resourceNsxtPolicyIntrusionServiceProfile(),
-			"nsxt_policy_evpn_tenant":                      resourceNsxtPolicyEvpnTenant(),
-			"nsxt_policy_evpn_config":                      resourceNsxtPolicyEvpnConfig(),
-			"nsxt_policy_evpn_tunnel_endpoint":             resourceNsxtPolicyEvpnTunnelEndpoint(),
-			"nsxt_policy_vni_pool":                         resourceNsxtPolicyVniPool(),
-			"nsxt_policy_qos_profile":                      resourceNsxtPolicyQosProfile(),
-			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
-			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
-			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
-			"nsxt_policy_mac_discovery_profile":            resourceNsxtPolicyMacDiscoveryProfile(),
-			"nsxt_policy_ipsec_vpn_ike_profile":            resourceNsxtPolicyIPSecVpnIkeProfile(),
-			"nsxt_policy_ipsec_vpn_tunnel_profile":         resourceNsxtPolicyIPSecVpnTunnelProfile(),
-			"nsxt_policy_ipsec_vpn_dpd_profile":            resourceNsxtPolicyIPSecVpnDpdProfile(),
-			"nsxt_policy_ipsec_vpn_session":                resourceNsxtPolicyIPSecVpnSession(),
-			"nsxt_policy_l2_vpn_session":                   resourceNsxtPolicyL2VPNSession(),
-			"nsxt_policy_ipsec_vpn_service":                resourceNsxtPolicyIPSecVpnService(),
-			"nsxt_policy_l2_vpn_service":                   resourceNsxtPolicyL2VpnService(),
-			"nsxt_policy_ipsec_vpn_local_endpoint":         resourceNsxtPolicyIPSecVpnLocalEndpoint(),
-			"nsxt_policy_ip_discovery_profile":             resourceNsxtPolicyIPDiscoveryProfile(),
-			"nsxt_policy_context_profile_custom_attribute": resourceNsxtPolicyContextProfileCustomAttribute(),
-			"nsxt_policy_segment_security_profile":         resourceNsxtPolicySegmentSecurityProfile(),
-			"nsxt_policy_spoof_guard_profile":              resourceNsxtPolicySpoofGuardProfile(),
-			"nsxt_policy_gateway_qos_profile":              resourceNsxtPolicyGatewayQosProfile(),
-			"nsxt_policy_project":                          resourceNsxtPolicyProject(),
-			"nsxt_policy_transport_zone":                   resourceNsxtPolicyTransportZone(),
-			"nsxt_policy_user_management_role":             resourceNsxtPolicyUserManagementRole(),
-			"nsxt_policy_user_management_role_binding":     resourceNsxtPolicyUserManagementRoleBinding(),
-			"nsxt_policy_ldap_identity_source":             resourceNsxtPolicyLdapIdentitySource(),
-			"nsxt_edge_cluster":                            resourceNsxtEdgeCluster(),
-			"nsxt_compute_manager":                         resourceNsxtComputeManager(),
-			"nsxt_manager_cluster":                         resourceNsxtManagerCluster(),
-			"nsxt_policy_uplink_host_switch_profile":       resourceNsxtUplinkHostSwitchProfile(),
-			"nsxt_node_user":                               resourceNsxtUsers(),
-			"nsxt_principal_identity":                      resourceNsxtPrincipalIdentity(),
-			"nsxt_edge_transport_node":                     resourceNsxtEdgeTransportNode(),
-			"nsxt_failure_domain":                          resourceNsxtFailureDomain(),
-			"nsxt_cluster_virtual_ip":                      resourceNsxtClusterVirualIP(),
-			"nsxt_policy_host_transport_node_profile":      resourceNsxtPolicyHostTransportNodeProfile(),
-			"nsxt_policy_host_transport_node":              resourceNsxtPolicyHostTransportNode(),
-			"nsxt_edge_high_availability_profile":          resourceNsxtEdgeHighAvailabilityProfile(),
-			"nsxt_policy_host_transport_node_collection":   resourceNsxtPolicyHostTransportNodeCollection(),
-			"nsxt_policy_lb_client_ssl_profile":            resourceNsxtPolicyLBClientSslProfile(),
-			"nsxt_policy_lb_http_application_profile":      resourceNsxtPolicyLBHttpApplicationProfile(),
-			"nsxt_policy_security_policy_rule":             resourceNsxtPolicySecurityPolicyRule(),
-			"nsxt_policy_parent_security_policy":           resourceNsxtPolicyParentSecurityPolicy(),
-			"nsxt_policy_firewall_exclude_list_member":     resourceNsxtPolicyFirewallExcludeListMember(),
-			"nsxt_policy_lb_http_monitor_profile":          resourceNsxtPolicyLBHttpMonitorProfile(),
-			"nsxt_policy_lb_https_monitor_profile":         resourceNsxtPolicyLBHttpsMonitorProfile(),
-			"nsxt_policy_lb_icmp_monitor_profile":          resourceNsxtPolicyLBIcmpMonitorProfile(),
-			"nsxt_policy_lb_passive_monitor_profile":       resourceNsxtPolicyLBPassiveMonitorProfile(),
-			"nsxt_policy_lb_tcp_monitor_profile":           resourceNsxtPolicyLBTcpMonitorProfile(),
-			"nsxt_policy_lb_udp_monitor_profile":           resourceNsxtPolicyLBUdpMonitorProfile(),
-			"nsxt_policy_tier0_gateway_gre_tunnel":         resourceNsxtPolicyTier0GatewayGRETunnel(),
-			"nsxt_upgrade_run":                             resourceNsxtUpgradeRun(),
-			"nsxt_upgrade_prepare":                         resourceNsxtUpgradePrepare(),
-			"nsxt_upgrade_precheck_acknowledge":            resourceNsxtUpgradePrecheckAcknowledge(),
+			"nsxt_dhcp_relay_profile":                        resourceNsxtDhcpRelayProfile(),
+			"nsxt_dhcp_relay_service":                        resourceNsxtDhcpRelayService(),
+			"nsxt_dhcp_server_profile":                       resourceNsxtDhcpServerProfile(),
+			"nsxt_logical_dhcp_server":                       resourceNsxtLogicalDhcpServer(),
+			"nsxt_dhcp_server_ip_pool":                       resourceNsxtDhcpServerIPPool(),
+			"nsxt_logical_switch":                            resourceNsxtLogicalSwitch(),
+			"nsxt_vlan_logical_switch":                       resourceNsxtVlanLogicalSwitch(),
+			"nsxt_logical_dhcp_port":                         resourceNsxtLogicalDhcpPort(),
+			"nsxt_logical_port":                              resourceNsxtLogicalPort(),
+			"nsxt_logical_tier0_router":                      resourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":                      resourceNsxtLogicalTier1Router(),
+			"nsxt_logical_router_centralized_service_port":   resourceNsxtLogicalRouterCentralizedServicePort(),
+			"nsxt_logical_router_downlink_port":              resourceNsxtLogicalRouterDownLinkPort(),
+			"nsxt_logical_router_link_port_on_tier0":         resourceNsxtLogicalRouterLinkPortOnTier0(),
+			"nsxt_logical_router_link_port_on_tier1":         resourceNsxtLogicalRouterLinkPortOnTier1(),
+			"nsxt_ip_discovery_switching_profile":            resourceNsxtIPDiscoverySwitchingProfile(),
+			"nsxt_mac_management_switching_profile":          resourceNsxtMacManagementSwitchingProfile(),
+			"nsxt_qos_switching_profile":                     resourceNsxtQosSwitchingProfile(),
+			"nsxt_spoofguard_switching_profile":              resourceNsxtSpoofGuardSwitchingProfile(),
+			"nsxt_switch_security_switching_profile":         resourceNsxtSwitchSecuritySwitchingProfile(),
+			"nsxt_l4_port_set_ns_service":                    resourceNsxtL4PortSetNsService(),
+			"nsxt_algorithm_type_ns_service":                 resourceNsxtAlgorithmTypeNsService(),
+			"nsxt_icmp_type_ns_service":                      resourceNsxtIcmpTypeNsService(),
+			"nsxt_igmp_type_ns_service":                      resourceNsxtIgmpTypeNsService(),
+			"nsxt_ether_type_ns_service":                     resourceNsxtEtherTypeNsService(),
+			"nsxt_ip_protocol_ns_service":                    resourceNsxtIPProtocolNsService(),
+			"nsxt_ns_service_group":                          resourceNsxtNsServiceGroup(),
+			"nsxt_ns_group":                                  resourceNsxtNsGroup(),
+			"nsxt_firewall_section":                          resourceNsxtFirewallSection(),
+			"nsxt_nat_rule":                                  resourceNsxtNatRule(),
+			"nsxt_ip_block":                                  resourceNsxtIPBlock(),
+			"nsxt_ip_block_subnet":                           resourceNsxtIPBlockSubnet(),
+			"nsxt_ip_pool":                                   resourceNsxtIPPool(),
+			"nsxt_ip_pool_allocation_ip_address":             resourceNsxtIPPoolAllocationIPAddress(),
+			"nsxt_ip_set":                                    resourceNsxtIPSet(),
+			"nsxt_static_route":                              resourceNsxtStaticRoute(),
+			"nsxt_vm_tags":                                   resourceNsxtVMTags(),
+			"nsxt_lb_icmp_monitor":                           resourceNsxtLbIcmpMonitor(),
+			"nsxt_lb_tcp_monitor":                            resourceNsxtLbTCPMonitor(),
+			"nsxt_lb_udp_monitor":                            resourceNsxtLbUDPMonitor(),
+			"nsxt_lb_http_monitor":                           resourceNsxtLbHTTPMonitor(),
+			"nsxt_lb_https_monitor":                          resourceNsxtLbHTTPSMonitor(),
+			"nsxt_lb_passive_monitor":                        resourceNsxtLbPassiveMonitor(),
+			"nsxt_lb_pool":                                   resourceNsxtLbPool(),
+			"nsxt_lb_tcp_virtual_server":                     resourceNsxtLbTCPVirtualServer(),
+			"nsxt_lb_udp_virtual_server":                     resourceNsxtLbUDPVirtualServer(),
+			"nsxt_lb_http_virtual_server":                    resourceNsxtLbHTTPVirtualServer(),
+			"nsxt_lb_http_forwarding_rule":                   resourceNsxtLbHTTPForwardingRule(),
+			"nsxt_lb_http_request_rewrite_rule":              resourceNsxtLbHTTPRequestRewriteRule(),
+			"nsxt_lb_http_response_rewrite_rule":             resourceNsxtLbHTTPResponseRewriteRule(),
+			"nsxt_lb_cookie_persistence_profile":             resourceNsxtLbCookiePersistenceProfile(),
+			"nsxt_lb_source_ip_persistence_profile":          resourceNsxtLbSourceIPPersistenceProfile(),
+			"nsxt_lb_client_ssl_profile":                     resourceNsxtLbClientSslProfile(),
+			"nsxt_lb_server_ssl_profile":                     resourceNsxtLbServerSslProfile(),
+			"nsxt_lb_service":                                resourceNsxtLbService(),
+			"nsxt_lb_fast_tcp_application_profile":           resourceNsxtLbFastTCPApplicationProfile(),
+			"nsxt_lb_fast_udp_application_profile":           resourceNsxtLbFastUDPApplicationProfile(),
+			"nsxt_lb_http_application_profile":               resourceNsxtLbHTTPApplicationProfile(),
+			"nsxt_policy_tier1_gateway":                      resourceNsxtPolicyTier1Gateway(),
+			"nsxt_policy_tier1_gateway_interface":            resourceNsxtPolicyTier1GatewayInterface(),
+			"nsxt_policy_tier0_gateway":                      resourceNsxtPolicyTier0Gateway(),
+			"nsxt_policy_tier0_gateway_interface":            resourceNsxtPolicyTier0GatewayInterface(),
+			"nsxt_policy_tier0_gateway_ha_vip_config":        resourceNsxtPolicyTier0GatewayHAVipConfig(),
+			"nsxt_policy_group":                              resourceNsxtPolicyGroup(),
+			"nsxt_policy_domain":                             resourceNsxtPolicyDomain(),
+			"nsxt_policy_security_policy":                    resourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_service":                            resourceNsxtPolicyService(),
+			"nsxt_policy_gateway_policy":                     resourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_predefined_gateway_policy":          resourceNsxtPolicyPredefinedGatewayPolicy(),
+			"nsxt_policy_predefined_security_policy":         resourceNsxtPolicyPredefinedSecurityPolicy(),
+			"nsxt_policy_segment":                            resourceNsxtPolicySegment(),
+			"nsxt_policy_segment_port":                       resourceNsxtPolicySegmentPort(),
+			"nsxt_policy_vlan_segment":                       resourceNsxtPolicyVlanSegment(),
+			"nsxt_policy_fixed_segment":                      resourceNsxtPolicyFixedSegment(),
+			"nsxt_policy_static_route":                       resourceNsxtPolicyStaticRoute(),
+			"nsxt_policy_gateway_prefix_list":                resourceNsxtPolicyGatewayPrefixList(),
+			"nsxt_policy_vm_tags":                            resourceNsxtPolicyVMTags(),
+			"nsxt_policy_nat_rule":                           resourceNsxtPolicyNATRule(),
+			"nsxt_policy_ip_block":                           resourceNsxtPolicyIPBlock(),
+			"nsxt_policy_lb_pool":                            resourceNsxtPolicyLBPool(),
+			"nsxt_policy_ip_pool":                            resourceNsxtPolicyIPPool(),
+			"nsxt_policy_ip_pool_block_subnet":               resourceNsxtPolicyIPPoolBlockSubnet(),
+			"nsxt_policy_ip_pool_static_subnet":              resourceNsxtPolicyIPPoolStaticSubnet(),
+			"nsxt_policy_lb_service":                         resourceNsxtPolicyLBService(),
+			"nsxt_policy_lb_virtual_server":                  resourceNsxtPolicyLBVirtualServer(),
+			"nsxt_policy_lb_virtual_server_rule":             resourceNsxtPolicyLBVirtualServerRule(),
+			"nsxt_policy_ip_address_allocation":              resourceNsxtPolicyIPAddressAllocation(),
+			"nsxt_policy_bgp_neighbor":                       resourceNsxtPolicyBgpNeighbor(),
+			"nsxt_policy_bgp_config":                         resourceNsxtPolicyBgpConfig(),
+			"nsxt_policy_dhcp_relay":                         resourceNsxtPolicyDhcpRelayConfig(),
+			"nsxt_policy_dhcp_server":                        resourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_context_profile":                    resourceNsxtPolicyContextProfile(),
+			"nsxt_policy_dhcp_v4_static_binding":             resourceNsxtPolicyDhcpV4StaticBinding(),
+			"nsxt_policy_dhcp_v6_static_binding":             resourceNsxtPolicyDhcpV6StaticBinding(),
+			"nsxt_policy_dns_forwarder_zone":                 resourceNsxtPolicyDNSForwarderZone(),
+			"nsxt_policy_gateway_dns_forwarder":              resourceNsxtPolicyGatewayDNSForwarder(),
+			"nsxt_policy_gateway_community_list":             resourceNsxtPolicyGatewayCommunityList(),
+			"nsxt_policy_gateway_route_map":                  resourceNsxtPolicyGatewayRouteMap(),
+			"nsxt_policy_intrusion_service_policy":           resourceNsxtPolicyIntrusionServicePolicy(),
+			"nsxt_policy_static_route_bfd_peer":              resourceNsxtPolicyStaticRouteBfdPeer(),
+			"nsxt_policy_intrusion_service_profile":          resourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_evpn_tenant":                        resourceNsxtPolicyEvpnTenant(),
+			"nsxt_policy_evpn_config":                        resourceNsxtPolicyEvpnConfig(),
+			"nsxt_policy_evpn_tunnel_endpoint":               resourceNsxtPolicyEvpnTunnelEndpoint(),
+			"nsxt_policy_vni_pool":                           resourceNsxtPolicyVniPool(),
+			"nsxt_policy_qos_profile":                        resourceNsxtPolicyQosProfile(),
+			"nsxt_policy_ospf_config":                        resourceNsxtPolicyOspfConfig(),
+			"nsxt_policy_ospf_area":                          resourceNsxtPolicyOspfArea(),
+			"nsxt_policy_gateway_redistribution_config":      resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_mac_discovery_profile":              resourceNsxtPolicyMacDiscoveryProfile(),
+			"nsxt_policy_ipsec_vpn_ike_profile":              resourceNsxtPolicyIPSecVpnIkeProfile(),
+			"nsxt_policy_ipsec_vpn_tunnel_profile":           resourceNsxtPolicyIPSecVpnTunnelProfile(),
+			"nsxt_policy_ipsec_vpn_dpd_profile":              resourceNsxtPolicyIPSecVpnDpdProfile(),
+			"nsxt_policy_ipsec_vpn_session":                  resourceNsxtPolicyIPSecVpnSession(),
+			"nsxt_policy_l2_vpn_session":                     resourceNsxtPolicyL2VPNSession(),
+			"nsxt_policy_segment_l2_extension":               resourceNsxtPolicySegmentL2Extension(),
+			"nsxt_policy_ipsec_vpn_service":                  resourceNsxtPolicyIPSecVpnService(),
+			"nsxt_policy_l2_vpn_service":                     resourceNsxtPolicyL2VpnService(),
+			"nsxt_policy_ipsec_vpn_local_endpoint":           resourceNsxtPolicyIPSecVpnLocalEndpoint(),
+			"nsxt_policy_ip_discovery_profile":               resourceNsxtPolicyIPDiscoveryProfile(),
+			"nsxt_policy_context_profile_custom_attribute":   resourceNsxtPolicyContextProfileCustomAttribute(),
+			"nsxt_policy_segment_security_profile":           resourceNsxtPolicySegmentSecurityProfile(),
+			"nsxt_policy_spoof_guard_profile":                resourceNsxtPolicySpoofGuardProfile(),
+			"nsxt_policy_gateway_qos_profile":                resourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_pim_profile":                        resourceNsxtPolicyPimProfile(),
+			"nsxt_policy_igmp_profile":                       resourceNsxtPolicyIgmpProfile(),
+			"nsxt_policy_project":                            resourceNsxtPolicyProject(),
+			"nsxt_vpc":                                       resourceNsxtVpc(),
+			"nsxt_vpc_subnet":                                resourceNsxtVpcSubnet(),
+			"nsxt_vpc_ip_address_allocation":                 resourceNsxtVpcIPAddressAllocation(),
+			"nsxt_vpc_group":                                 resourceNsxtVpcGroup(),
+			"nsxt_vpc_security_policy":                       resourceNsxtVpcSecurityPolicy(),
+			"nsxt_policy_transport_zone":                     resourceNsxtPolicyTransportZone(),
+			"nsxt_policy_user_management_role":               resourceNsxtPolicyUserManagementRole(),
+			"nsxt_policy_user_management_role_binding":       resourceNsxtPolicyUserManagementRoleBinding(),
+			"nsxt_policy_ldap_identity_source":               resourceNsxtPolicyLdapIdentitySource(),
+			"nsxt_edge_cluster":                              resourceNsxtEdgeCluster(),
+			"nsxt_compute_manager":                           resourceNsxtComputeManager(),
+			"nsxt_manager_cluster":                           resourceNsxtManagerCluster(),
+			"nsxt_policy_uplink_host_switch_profile":         resourceNsxtUplinkHostSwitchProfile(),
+			"nsxt_node_user":                                 resourceNsxtUsers(),
+			"nsxt_principal_identity":                        resourceNsxtPrincipalIdentity(),
+			"nsxt_edge_transport_node":                       resourceNsxtEdgeTransportNode(),
+			"nsxt_failure_domain":                            resourceNsxtFailureDomain(),
+			"nsxt_cluster_virtual_ip":                        resourceNsxtClusterVirualIP(),
+			"nsxt_manager_api_certificate":                   resourceNsxtManagerAPICertificate(),
+			"nsxt_manager_node_settings":                     resourceNsxtManagerNodeSettings(),
+			"nsxt_central_node_config_profile":               resourceNsxtCentralNodeConfigProfile(),
+			"nsxt_backup_config":                             resourceNsxtBackupConfig(),
+			"nsxt_backup_run":                                resourceNsxtBackupRun(),
+			"nsxt_policy_host_transport_node_profile":        resourceNsxtPolicyHostTransportNodeProfile(),
+			"nsxt_policy_host_transport_node":                resourceNsxtPolicyHostTransportNode(),
+			"nsxt_edge_high_availability_profile":            resourceNsxtEdgeHighAvailabilityProfile(),
+			"nsxt_policy_host_transport_node_collection":     resourceNsxtPolicyHostTransportNodeCollection(),
+			"nsxt_policy_lb_client_ssl_profile":              resourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_http_application_profile":        resourceNsxtPolicyLBHttpApplicationProfile(),
+			"nsxt_policy_lb_cookie_persistence_profile":      resourceNsxtPolicyLBCookiePersistenceProfile(),
+			"nsxt_policy_lb_source_ip_persistence_profile":   resourceNsxtPolicyLBSourceIPPersistenceProfile(),
+			"nsxt_policy_lb_generic_persistence_profile":     resourceNsxtPolicyLBGenericPersistenceProfile(),
+			"nsxt_policy_lb_server_ssl_profile":              resourceNsxtPolicyLBServerSslProfile(),
+			"nsxt_policy_lb_fast_tcp_application_profile":    resourceNsxtPolicyLBFastTcpApplicationProfile(),
+			"nsxt_policy_lb_fast_udp_application_profile":    resourceNsxtPolicyLBFastUdpApplicationProfile(),
+			"nsxt_policy_security_policy_rule":               resourceNsxtPolicySecurityPolicyRule(),
+			"nsxt_policy_parent_security_policy":             resourceNsxtPolicyParentSecurityPolicy(),
+			"nsxt_policy_firewall_exclude_list_member":       resourceNsxtPolicyFirewallExcludeListMember(),
+			"nsxt_policy_lb_http_monitor_profile":            resourceNsxtPolicyLBHttpMonitorProfile(),
+			"nsxt_policy_lb_https_monitor_profile":           resourceNsxtPolicyLBHttpsMonitorProfile(),
+			"nsxt_policy_lb_icmp_monitor_profile":            resourceNsxtPolicyLBIcmpMonitorProfile(),
+			"nsxt_policy_lb_passive_monitor_profile":         resourceNsxtPolicyLBPassiveMonitorProfile(),
+			"nsxt_policy_lb_tcp_monitor_profile":             resourceNsxtPolicyLBTcpMonitorProfile(),
+			"nsxt_policy_lb_udp_monitor_profile":             resourceNsxtPolicyLBUdpMonitorProfile(),
+			"nsxt_policy_tier0_gateway_gre_tunnel":           resourceNsxtPolicyTier0GatewayGRETunnel(),
+			"nsxt_upgrade_run":                               resourceNsxtUpgradeRun(),
+			"nsxt_upgrade_prepare":                           resourceNsxtUpgradePrepare(),
+			"nsxt_upgrade_precheck_acknowledge":              resourceNsxtUpgradePrecheckAcknowledge(),
+			"nsxt_policy_tls_inspection_policy":              resourceNsxtPolicyTLSInspectionPolicy(),
+			"nsxt_policy_tls_inspection_internal_profile":    resourceNsxtPolicyTLSInspectionInternalProfile(),
+			"nsxt_policy_tls_inspection_external_profile":    resourceNsxtPolicyTLSInspectionExternalProfile(),
+			"nsxt_policy_ca_bundle":                          resourceNsxtPolicyCaBundle(),
+			"nsxt_policy_certificate":                        resourceNsxtPolicyCertificate(),
+			"nsxt_policy_certificate_signing_request":        resourceNsxtPolicyCertificateSigningRequest(),
+			"nsxt_policy_signed_certificate":                 resourceNsxtPolicySignedCertificate(),
+			"nsxt_policy_malware_prevention_service_profile": resourceNsxtPolicyMalwarePreventionServiceProfile(),
+			"nsxt_policy_malware_prevention_policy":          resourceNsxtPolicyMalwarePreventionPolicy(),
+			"nsxt_policy_malware_prevention_gateway_policy":  resourceNsxtPolicyMalwarePreventionGatewayPolicy(),
+			"nsxt_policy_service_chain":                      resourceNsxtPolicyServiceChain(),
+			"nsxt_policy_redirection_policy":                 resourceNsxtPolicyRedirectionPolicy(),
+			"nsxt_policy_firewall_global_settings":           resourceNsxtPolicyFirewallGlobalSettings(),
+			"nsxt_policy_dfw_cpu_mem_thresholds_profile":     resourceNsxtPolicyDfwCPUMemThresholdsProfile(),
+			"nsxt_policy_dfw_cpu_mem_thresholds_binding":     resourceNsxtPolicyDfwCPUMemThresholdsBinding(),
+			"nsxt_policy_idfw_compute_collection":            resourceNsxtPolicyIdfwComputeCollection(),
+			"nsxt_policy_idfw_ad_domain":                     resourceNsxtPolicyIdfwAdDomain(),
+			"nsxt_policy_idfw_event_log_server":              resourceNsxtPolicyIdfwEventLogServer(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -1200,15 +1247,35 @@ func getProjectIDFromSchema(d *schema.ResourceData) string {
 	return ""
 }
 
+func getVPCIDFromSchema(d *schema.ResourceData) string {
+	ctxPtr := d.Get("context")
+	if ctxPtr != nil {
+		contexts := ctxPtr.([]interface{})
+		for _, context := range contexts {
+			data := context.(map[string]interface{})
+			vpcID, ok := data["vpc_id"]
+			if !ok {
+				return ""
+			}
+
+			return vpcID.(string)
+		}
+	}
+	return ""
+}
+
 func getSessionContext(d *schema.ResourceData, m interface{}) tf_api.SessionContext {
 	var clientType tf_api.ClientType
 	projectID := getProjectIDFromSchema(d)
-	if projectID != "" {
+	vpcID := getVPCIDFromSchema(d)
+	if vpcID != "" {
+		clientType = tf_api.VPC
+	} else if projectID != "" {
 		clientType = tf_api.Multitenancy
 	} else if isPolicyGlobalManager(m) {
 		clientType = tf_api.Global
 	} else {
 		clientType = tf_api.Local
 	}
-	return tf_api.SessionContext{ProjectID: projectID, ClientType: clientType}
+	return tf_api.SessionContext{ProjectID: projectID, VPCID: vpcID, ClientType: clientType}
 }
@@ -0,0 +1,395 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster/backups"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func resourceNsxtBackupConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtBackupConfigCreate,
+		Read:   resourceNsxtBackupConfigRead,
+		Update: resourceNsxtBackupConfigUpdate,
+		Delete: resourceNsxtBackupConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether automated backup is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "Hostname or IP address of the SFTP server",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Port of the SFTP server",
+				Optional:     true,
+				Default:      22,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"directory_path": {
+				Type:        schema.TypeString,
+				Description: "Directory on the SFTP server to store backup files in",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "User name to authenticate with the SFTP server",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password to authenticate with the SFTP server",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"ssh_fingerprint": {
+				Type:        schema.TypeString,
+				Description: "Expected SSH fingerprint of the SFTP server. If not specified, fingerprint presented by the server on first apply is used",
+				Optional:    true,
+				Computed:    true,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Passphrase used to encrypt backup files",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"weekly_schedule": {
+				Type:          schema.TypeList,
+				Description:   "Weekly backup schedule",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"interval_schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_week": {
+							Type:        schema.TypeList,
+							Description: "Days of week when backup is taken, 0 being Sunday",
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"hour_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Hour of day when backup is taken",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minute_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Minute of hour when backup is taken",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+			"interval_schedule": {
+				Type:          schema.TypeList,
+				Description:   "Interval backup schedule",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"weekly_schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"seconds_between_backups": {
+							Type:         schema.TypeInt,
+							Description:  "Time interval in seconds between two consecutive automated backups",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(300, 86400),
+						},
+					},
+				},
+			},
+			"inventory_summary_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of seconds between uploads of inventory summary to backup server",
+				Optional:     true,
+				Default:      240,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
+			"after_config_change_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Number of seconds after last backup that need to pass before configuration change triggers a new backup. If not specified, configuration changes do not trigger backups",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+		},
+	}
+}
+
+func getBackupConfigScheduleFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	weekly := d.Get("weekly_schedule").([]interface{})
+	if len(weekly) > 0 && weekly[0] != nil {
+		schedule := weekly[0].(map[string]interface{})
+		hourOfDay := int64(schedule["hour_of_day"].(int))
+		minuteOfDay := int64(schedule["minute_of_day"].(int))
+		obj := nsxModel.WeeklyBackupSchedule{
+			DaysOfWeek:   interface2Int64List(schedule["days_of_week"].([]interface{})),
+			HourOfDay:    &hourOfDay,
+			MinuteOfDay:  &minuteOfDay,
+			ResourceType: nsxModel.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE,
+		}
+		dataValue, errs := converter.ConvertToVapi(obj, nsxModel.WeeklyBackupScheduleBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	interval := d.Get("interval_schedule").([]interface{})
+	if len(interval) > 0 && interval[0] != nil {
+		schedule := interval[0].(map[string]interface{})
+		seconds := int64(schedule["seconds_between_backups"].(int))
+		obj := nsxModel.IntervalBackupSchedule{
+			SecondsBetweenBackups: &seconds,
+			ResourceType:          nsxModel.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE,
+		}
+		dataValue, errs := converter.ConvertToVapi(obj, nsxModel.IntervalBackupScheduleBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+		return dataValue.(*data.StructValue), nil
+	}
+
+	return nil, nil
+}
+
+func setBackupConfigScheduleInSchema(d *schema.ResourceData, schedule *data.StructValue) error {
+	d.Set("weekly_schedule", nil)
+	d.Set("interval_schedule", nil)
+	if schedule == nil {
+		return nil
+	}
+
+	converter := bindings.NewTypeConverter()
+	base, errs := converter.ConvertToGolang(schedule, nsxModel.BackupScheduleBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	switch base.(nsxModel.BackupSchedule).ResourceType {
+	case nsxModel.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE:
+		weekly, errs := converter.ConvertToGolang(schedule, nsxModel.WeeklyBackupScheduleBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obj := weekly.(nsxModel.WeeklyBackupSchedule)
+		elem := make(map[string]interface{})
+		var days []interface{}
+		for _, day := range obj.DaysOfWeek {
+			days = append(days, int(day))
+		}
+		elem["days_of_week"] = days
+		elem["hour_of_day"] = obj.HourOfDay
+		elem["minute_of_day"] = obj.MinuteOfDay
+		d.Set("weekly_schedule", []interface{}{elem})
+	case nsxModel.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE:
+		interval, errs := converter.ConvertToGolang(schedule, nsxModel.IntervalBackupScheduleBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obj := interval.(nsxModel.IntervalBackupSchedule)
+		elem := make(map[string]interface{})
+		elem["seconds_between_backups"] = obj.SecondsBetweenBackups
+		d.Set("interval_schedule", []interface{}{elem})
+	}
+
+	return nil
+}
+
+// Retrieve fingerprint presented by the SFTP server, and verify it matches
+// the configured fingerprint. If fingerprint is not configured, the one accepted
+// on first apply is expected, unless the server has changed.
+func getBackupConfigSSHFingerprint(d *schema.ResourceData, m interface{}) (string, error) {
+	server := d.Get("server").(string)
+	port := int64(d.Get("port").(int))
+	client := cluster.NewBackupsClient(getPolicyConnector(m))
+	obj, err := client.Retrievesshfingerprint(nsxModel.RemoteServerFingerprintRequest{
+		Server: &server,
+		Port:   &port,
+	})
+	if err != nil {
+		return "", err
+	}
+	if obj.SshFingerprint == nil {
+		return "", fmt.Errorf("Failed to retrieve SSH fingerprint of %s", server)
+	}
+
+	expected := ""
+	if configured := d.GetRawConfig().GetAttr("ssh_fingerprint"); !configured.IsNull() {
+		expected = configured.AsString()
+	} else if d.Id() != "" && !d.HasChange("server") && !d.HasChange("port") {
+		stored, _ := d.GetChange("ssh_fingerprint")
+		expected = stored.(string)
+	}
+	if expected != "" && expected != *obj.SshFingerprint {
+		return "", fmt.Errorf("SSH fingerprint of %s is %s, which does not match the expected fingerprint %s", server, *obj.SshFingerprint, expected)
+	}
+	return *obj.SshFingerprint, nil
+}
+
+func applyBackupConfig(d *schema.ResourceData, m interface{}) error {
+	fingerprint, err := getBackupConfigSSHFingerprint(d, m)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := getBackupConfigScheduleFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	enabled := d.Get("enabled").(bool)
+	server := d.Get("server").(string)
+	port := int64(d.Get("port").(int))
+	directoryPath := d.Get("directory_path").(string)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	passphrase := d.Get("passphrase").(string)
+	inventorySummaryInterval := int64(d.Get("inventory_summary_interval").(int))
+	protocolName := nsxModel.FileTransferProtocol_PROTOCOL_NAME_SFTP
+	schemeName := nsxModel.FileTransferAuthenticationScheme_SCHEME_NAME_PASSWORD
+
+	obj := nsxModel.BackupConfiguration{
+		BackupEnabled:            &enabled,
+		BackupSchedule:           schedule,
+		InventorySummaryInterval: &inventorySummaryInterval,
+		Passphrase:               &passphrase,
+		RemoteFileServer: &nsxModel.RemoteFileServer{
+			Server:        &server,
+			Port:          &port,
+			DirectoryPath: &directoryPath,
+			Protocol: &nsxModel.FileTransferProtocol{
+				ProtocolName:   &protocolName,
+				SshFingerprint: &fingerprint,
+				AuthenticationScheme: &nsxModel.FileTransferAuthenticationScheme{
+					SchemeName: &schemeName,
+					Username:   &username,
+					Password:   &password,
+				},
+			},
+		},
+	}
+
+	afterConfigChangeInterval := int64(d.Get("after_config_change_interval").(int))
+	if afterConfigChangeInterval > 0 {
+		obj.AfterInventoryUpdateInterval = &afterConfigChangeInterval
+	}
+
+	client := backups.NewConfigClient(getPolicyConnector(m))
+	_, err = client.Update(obj, nil, nil)
+	return err
+}
+
+func resourceNsxtBackupConfigCreate(d *schema.ResourceData, m interface{}) error {
+	client := nsx.NewClusterClient(getPolicyConnector(m))
+	clusterConfig, err := client.Get()
+	if err != nil {
+		return handleCreateError("BackupConfig", "", err)
+	}
+	id := *clusterConfig.ClusterId
+
+	log.Printf("[INFO] Configuring backup for cluster %s", id)
+	err = applyBackupConfig(d, m)
+	if err != nil {
+		return handleCreateError("BackupConfig", id, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtBackupConfigRead(d, m)
+}
+
+// Password and passphrase are not returned by NSX, hence they are kept as is
+func resourceNsxtBackupConfigRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BackupConfig ID")
+	}
+
+	client := backups.NewConfigClient(getPolicyConnector(m))
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "BackupConfig", id, err)
+	}
+
+	d.Set("enabled", obj.BackupEnabled)
+	d.Set("inventory_summary_interval", obj.InventorySummaryInterval)
+	d.Set("after_config_change_interval", obj.AfterInventoryUpdateInterval)
+	if obj.RemoteFileServer != nil {
+		d.Set("server", obj.RemoteFileServer.Server)
+		d.Set("port", obj.RemoteFileServer.Port)
+		d.Set("directory_path", obj.RemoteFileServer.DirectoryPath)
+		protocol := obj.RemoteFileServer.Protocol
+		if protocol != nil {
+			d.Set("ssh_fingerprint", protocol.SshFingerprint)
+			if protocol.AuthenticationScheme != nil {
+				d.Set("username", protocol.AuthenticationScheme.Username)
+			}
+		}
+	}
+
+	return setBackupConfigScheduleInSchema(d, obj.BackupSchedule)
+}
+
+func resourceNsxtBackupConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	err := applyBackupConfig(d, m)
+	if err != nil {
+		return handleUpdateError("BackupConfig", id, err)
+	}
+
+	return resourceNsxtBackupConfigRead(d, m)
+}
+
+// Backup configuration can not be removed, hence automated backup is disabled
+func resourceNsxtBackupConfigDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	client := backups.NewConfigClient(getPolicyConnector(m))
+	obj, err := client.Get()
+	if err != nil {
+		return handleDeleteError("BackupConfig", id, err)
+	}
+
+	enabled := false
+	obj.BackupEnabled = &enabled
+	// Secrets are not returned by NSX, and are required on update
+	passphrase := d.Get("passphrase").(string)
+	obj.Passphrase = &passphrase
+	if obj.RemoteFileServer != nil && obj.RemoteFileServer.Protocol != nil && obj.RemoteFileServer.Protocol.AuthenticationScheme != nil {
+		password := d.Get("password").(string)
+		obj.RemoteFileServer.Protocol.AuthenticationScheme.Password = &password
+	}
+
+	_, err = client.Update(obj, nil, nil)
+	if err != nil {
+		return handleDeleteError("BackupConfig", id, err)
+	}
+
+	return nil
+}
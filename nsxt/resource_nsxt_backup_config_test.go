@@ -0,0 +1,87 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtBackupConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_backup_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_USERNAME")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_PASSWORD")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtBackupConfigWeeklyTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "server", getTestBackupServer()),
+					resource.TestCheckResourceAttr(testResourceName, "port", "22"),
+					resource.TestCheckResourceAttrSet(testResourceName, "ssh_fingerprint"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.days_of_week.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.hour_of_day", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "inventory_summary_interval", "240"),
+				),
+			},
+			{
+				Config: testAccNsxtBackupConfigIntervalTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.0.seconds_between_backups", "3600"),
+					resource.TestCheckResourceAttr(testResourceName, "inventory_summary_interval", "300"),
+					resource.TestCheckResourceAttr(testResourceName, "after_config_change_interval", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtBackupConfigCommonTemplate() string {
+	return fmt.Sprintf(`
+  server         = "%s"
+  directory_path = "/backups/nsx"
+  username       = "%s"
+  password       = "%s"
+  passphrase     = "Backup-Pass-123!"
+`, getTestBackupServer(), getTestBackupUsername(), getTestBackupPassword())
+}
+
+func testAccNsxtBackupConfigWeeklyTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_backup_config" "test" {
+%s
+  weekly_schedule {
+    days_of_week  = [1, 4]
+    hour_of_day   = 2
+    minute_of_day = 30
+  }
+}`, testAccNsxtBackupConfigCommonTemplate())
+}
+
+func testAccNsxtBackupConfigIntervalTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_backup_config" "test" {
+%s
+  interval_schedule {
+    seconds_between_backups = 3600
+  }
+
+  inventory_summary_interval   = 300
+  after_config_change_interval = 600
+}`, testAccNsxtBackupConfigCommonTemplate())
+}
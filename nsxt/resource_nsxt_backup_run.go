@@ -0,0 +1,243 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster/backups"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+var (
+	defaultBackupStatusCheckInterval = 10
+	defaultBackupStatusCheckTimeout  = 3600
+	defaultBackupStatusCheckDelay    = 5
+)
+
+const (
+	backupRunStateInProgress = "IN_PROGRESS"
+	backupRunStateDone       = "DONE"
+)
+
+func resourceNsxtBackupRun() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtBackupRunCreate,
+		Read:   resourceNsxtBackupRunRead,
+		Delete: resourceNsxtBackupRunDelete,
+
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, trigger a new backup",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Backup status check timeout in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultBackupStatusCheckTimeout,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval to check backup status in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultBackupStatusCheckInterval,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delay": {
+				Type:         schema.TypeInt,
+				Description:  "Initial delay to start backup status checks in seconds",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultBackupStatusCheckDelay,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the cluster backup",
+				Computed:    true,
+			},
+			"start_time": {
+				Type:        schema.TypeInt,
+				Description: "Time when backup was started, in epoch milliseconds",
+				Computed:    true,
+			},
+			"end_time": {
+				Type:        schema.TypeInt,
+				Description: "Time when backup was completed, in epoch milliseconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Backup is considered complete once it was observed in progress and is no
+// longer running, or once it appears in backup history
+func waitForBackupComplete(d *schema.ResourceData, m interface{}, startedAfter int64) (string, error) {
+	connector := getPolicyConnector(m)
+	client := backups.NewStatusClient(connector)
+	backupID := ""
+	observed := false
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{backupRunStateInProgress},
+		Target:  []string{backupRunStateDone},
+		Refresh: func() (interface{}, string, error) {
+			state, err := client.Get()
+			if err != nil {
+				return state, backupRunStateInProgress, logAPIError("Error retrieving backup status", err)
+			}
+			if state.OperationType == nil || *state.OperationType != nsxModel.CurrentBackupOperationStatus_OPERATION_TYPE_BACKUP {
+				if observed {
+					return state, backupRunStateDone, nil
+				}
+				// Backup might have not started yet, or completed between polls
+				status, err := getBackupRunStatus(m, "", startedAfter)
+				if err != nil {
+					return state, backupRunStateInProgress, logAPIError("Error retrieving backup history", err)
+				}
+				if status != nil {
+					if status.BackupId != nil {
+						backupID = *status.BackupId
+					}
+					return state, backupRunStateDone, nil
+				}
+				log.Printf("[DEBUG] Backup is not observed yet")
+				return state, backupRunStateInProgress, nil
+			}
+			observed = true
+			if state.BackupId != nil {
+				backupID = *state.BackupId
+			}
+			if state.CurrentStep != nil {
+				log.Printf("[DEBUG] Current step for backup %s is %s", backupID, *state.CurrentStep)
+			}
+			return state, backupRunStateInProgress, nil
+		},
+		Timeout:      time.Duration(d.Get("timeout").(int)) * time.Second,
+		PollInterval: time.Duration(d.Get("interval").(int)) * time.Second,
+		Delay:        time.Duration(d.Get("delay").(int)) * time.Second,
+	}
+	_, err := stateConf.WaitForState()
+	return backupID, err
+}
+
+// Find status of the cluster backup, either by its ID, or the latest backup
+// started after the given time if ID is not known
+func getBackupRunStatus(m interface{}, backupID string, startedAfter int64) (*nsxModel.BackupOperationStatus, error) {
+	client := backups.NewHistoryClient(getPolicyConnector(m))
+	history, err := client.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	var result *nsxModel.BackupOperationStatus
+	for i, status := range history.ClusterBackupStatuses {
+		if backupID != "" {
+			if status.BackupId != nil && *status.BackupId == backupID {
+				return &history.ClusterBackupStatuses[i], nil
+			}
+			continue
+		}
+		if status.StartTime == nil || *status.StartTime < startedAfter {
+			continue
+		}
+		if result == nil || *status.StartTime > *result.StartTime {
+			result = &history.ClusterBackupStatuses[i]
+		}
+	}
+	return result, nil
+}
+
+func resourceNsxtBackupRunCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := nsx.NewClusterClient(connector)
+
+	// Backup ID is not returned when backup is triggered, hence the latest backup
+	// is recorded in order to identify the new one if ID is not observed while polling
+	previous, err := getBackupRunStatus(m, "", 0)
+	if err != nil {
+		return handleCreateError("BackupRun", "", err)
+	}
+	var startedAfter int64
+	if previous != nil {
+		startedAfter = *previous.StartTime + 1
+	}
+
+	log.Printf("[INFO] Triggering cluster backup")
+	err = client.Backuptoremote(nil, nil)
+	if err != nil {
+		return handleCreateError("BackupRun", "", err)
+	}
+
+	backupID, err := waitForBackupComplete(d, m, startedAfter)
+	if err != nil {
+		return handleCreateError("BackupRun", backupID, fmt.Errorf("Failed to wait for backup to complete: %v", err))
+	}
+
+	status, err := getBackupRunStatus(m, backupID, startedAfter)
+	if err != nil {
+		return handleCreateError("BackupRun", backupID, err)
+	}
+	if status == nil || status.BackupId == nil {
+		return handleCreateError("BackupRun", backupID, fmt.Errorf("Failed to find status of triggered backup"))
+	}
+	if status.Success == nil || !*status.Success {
+		errorMessage := ""
+		if status.ErrorMessage != nil {
+			errorMessage = *status.ErrorMessage
+		}
+		return handleCreateError("BackupRun", *status.BackupId, fmt.Errorf("Backup failed: %s", errorMessage))
+	}
+
+	d.SetId(*status.BackupId)
+	d.Set("backup_id", status.BackupId)
+	d.Set("start_time", status.StartTime)
+	d.Set("end_time", status.EndTime)
+
+	return resourceNsxtBackupRunRead(d, m)
+}
+
+// Backup history is limited in size, hence backup that was rotated out of history
+// is kept in state
+func resourceNsxtBackupRunRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BackupRun ID")
+	}
+
+	status, err := getBackupRunStatus(m, id, 0)
+	if err != nil {
+		return handleReadError(d, "BackupRun", id, err)
+	}
+	if status == nil {
+		log.Printf("[DEBUG] Backup %s not found in backup history", id)
+		return nil
+	}
+
+	d.Set("backup_id", status.BackupId)
+	d.Set("start_time", status.StartTime)
+	d.Set("end_time", status.EndTime)
+
+	return nil
+}
+
+func resourceNsxtBackupRunDelete(d *schema.ResourceData, m interface{}) error {
+	// Backup files are kept on the backup server
+	log.Printf("[INFO] Backup run %s is removed from state only", d.Id())
+	return nil
+}
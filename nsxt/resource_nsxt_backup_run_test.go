@@ -0,0 +1,58 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtBackupRun_basic(t *testing.T) {
+	testResourceName := "nsxt_backup_run.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_USERNAME")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_PASSWORD")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtBackupRunTemplate("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(testResourceName, "end_time"),
+				),
+			},
+			{
+				Config: testAccNsxtBackupRunTemplate("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "backup_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtBackupRunTemplate(run string) string {
+	return fmt.Sprintf(`
+resource "nsxt_backup_config" "test" {
+%s
+  enabled = false
+}
+
+resource "nsxt_backup_run" "test" {
+  triggers = {
+    run = "%s"
+  }
+
+  depends_on = [nsxt_backup_config.test]
+}`, testAccNsxtBackupConfigCommonTemplate(), run)
+}
@@ -0,0 +1,412 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/configs/central_config"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/node/services"
+)
+
+func resourceNsxtCentralNodeConfigProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtCentralNodeConfigProfileCreate,
+		Read:   resourceNsxtCentralNodeConfigProfileRead,
+		Update: resourceNsxtCentralNodeConfigProfileUpdate,
+		Delete: resourceNsxtCentralNodeConfigProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the central node config profile. If not specified, the default profile is used",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the profile",
+				Computed:    true,
+			},
+			"ntp_servers": {
+				Type:        schema.TypeList,
+				Description: "List of NTP servers",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timezone": {
+				Type:        schema.TypeString,
+				Description: "Timezone to be set for NSX nodes",
+				Optional:    true,
+				Computed:    true,
+			},
+			"snmp_v3": {
+				Type:        schema.TypeList,
+				Description: "SNMP v3 configuration",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_protocol": {
+							Type:         schema.TypeString,
+							Description:  "Authentication protocol used for SNMP v3 communication",
+							Optional:     true,
+							Default:      nsxModel.Snmpv3Properties_AUTH_PROTOCOL_SHA1,
+							ValidateFunc: validation.StringInSlice([]string{nsxModel.Snmpv3Properties_AUTH_PROTOCOL_SHA1}, false),
+						},
+						"priv_protocol": {
+							Type:         schema.TypeString,
+							Description:  "Privacy protocol used for SNMP v3 communication",
+							Optional:     true,
+							Default:      nsxModel.Snmpv3Properties_PRIV_PROTOCOL_AES128,
+							ValidateFunc: validation.StringInSlice([]string{nsxModel.Snmpv3Properties_PRIV_PROTOCOL_AES128}, false),
+						},
+						"user": {
+							Type:        schema.TypeList,
+							Description: "SNMP v3 users allowed to poll NSX nodes",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"user_id": {
+										Type:        schema.TypeString,
+										Description: "Unique SNMP v3 user ID",
+										Required:    true,
+									},
+									"access": {
+										Type:        schema.TypeString,
+										Description: "Access permissions for polling NSX nodes",
+										Optional:    true,
+										Default:     nsxModel.CCSnmpV3User_ACCESS_ONLY,
+									},
+									"security_level": {
+										Type:        schema.TypeString,
+										Description: "Security level of SNMP communication for this user",
+										Optional:    true,
+										Default:     nsxModel.CCSnmpV3User_SECURITY_LEVEL_PRIV,
+									},
+									"auth_password": {
+										Type:        schema.TypeString,
+										Description: "Authentication password",
+										Required:    true,
+										Sensitive:   true,
+									},
+									"priv_password": {
+										Type:        schema.TypeString,
+										Description: "Privacy password",
+										Required:    true,
+										Sensitive:   true,
+									},
+								},
+							},
+						},
+						"target": {
+							Type:        schema.TypeList,
+							Description: "SNMP v3 targets where traps are sent from NSX nodes",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server": {
+										Type:        schema.TypeString,
+										Description: "IP address or FQDN of the target server",
+										Required:    true,
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Description:  "Port of the target server",
+										Optional:     true,
+										Default:      162,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"user_id": {
+										Type:        schema.TypeString,
+										Description: "SNMP v3 user ID used to notify target server",
+										Required:    true,
+									},
+									"security_level": {
+										Type:        schema.TypeString,
+										Description: "Security level of SNMP communication with the target",
+										Optional:    true,
+										Default:     nsxModel.Snmpv3Target_SECURITY_LEVEL_PRIV,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getCentralNodeConfigSnmpV3FromSchema(d *schema.ResourceData) *nsxModel.Snmpv3Properties {
+	snmpList := d.Get("snmp_v3").([]interface{})
+	if len(snmpList) == 0 || snmpList[0] == nil {
+		return nil
+	}
+
+	data := snmpList[0].(map[string]interface{})
+	authProtocol := data["auth_protocol"].(string)
+	privProtocol := data["priv_protocol"].(string)
+	result := nsxModel.Snmpv3Properties{
+		AuthProtocol: &authProtocol,
+		PrivProtocol: &privProtocol,
+	}
+
+	for _, user := range data["user"].([]interface{}) {
+		userData := user.(map[string]interface{})
+		userID := userData["user_id"].(string)
+		access := userData["access"].(string)
+		securityLevel := userData["security_level"].(string)
+		authPassword := userData["auth_password"].(string)
+		privPassword := userData["priv_password"].(string)
+		result.CcUsers = append(result.CcUsers, nsxModel.CCSnmpV3User{
+			UserId:        &userID,
+			Access:        &access,
+			SecurityLevel: &securityLevel,
+			AuthPassword:  &authPassword,
+			PrivPassword:  &privPassword,
+		})
+	}
+
+	for _, target := range data["target"].([]interface{}) {
+		targetData := target.(map[string]interface{})
+		server := targetData["server"].(string)
+		port := int64(targetData["port"].(int))
+		userID := targetData["user_id"].(string)
+		securityLevel := targetData["security_level"].(string)
+		result.Targets = append(result.Targets, nsxModel.Snmpv3Target{
+			Server:        &server,
+			Port:          &port,
+			UserId:        &userID,
+			SecurityLevel: &securityLevel,
+		})
+	}
+
+	return &result
+}
+
+// Passwords are not returned by NSX, hence they are taken from state
+func setCentralNodeConfigSnmpV3InSchema(d *schema.ResourceData, snmp *nsxModel.Snmpv3Properties) {
+	if snmp == nil {
+		d.Set("snmp_v3", nil)
+		return
+	}
+
+	passwords := make(map[string]map[string]interface{})
+	snmpList := d.Get("snmp_v3").([]interface{})
+	if len(snmpList) > 0 && snmpList[0] != nil {
+		for _, user := range snmpList[0].(map[string]interface{})["user"].([]interface{}) {
+			userData := user.(map[string]interface{})
+			passwords[userData["user_id"].(string)] = userData
+		}
+	}
+
+	elem := make(map[string]interface{})
+	elem["auth_protocol"] = snmp.AuthProtocol
+	elem["priv_protocol"] = snmp.PrivProtocol
+
+	var users []interface{}
+	for _, user := range snmp.CcUsers {
+		userElem := make(map[string]interface{})
+		userElem["user_id"] = user.UserId
+		userElem["access"] = user.Access
+		userElem["security_level"] = user.SecurityLevel
+		if user.UserId != nil {
+			if data, ok := passwords[*user.UserId]; ok {
+				userElem["auth_password"] = data["auth_password"]
+				userElem["priv_password"] = data["priv_password"]
+			}
+		}
+		users = append(users, userElem)
+	}
+	elem["user"] = users
+
+	var targets []interface{}
+	for _, target := range snmp.Targets {
+		targetElem := make(map[string]interface{})
+		targetElem["server"] = target.Server
+		targetElem["port"] = target.Port
+		targetElem["user_id"] = target.UserId
+		targetElem["security_level"] = target.SecurityLevel
+		targets = append(targets, targetElem)
+	}
+	elem["target"] = targets
+
+	d.Set("snmp_v3", []interface{}{elem})
+}
+
+func getCentralNodeConfigProfileID(d *schema.ResourceData, m interface{}) (string, error) {
+	profileID := d.Get("profile_id").(string)
+	if profileID != "" {
+		return profileID, nil
+	}
+
+	client := central_config.NewNodeConfigProfilesClient(getPolicyConnector(m))
+	profiles, err := client.List()
+	if err != nil {
+		return "", err
+	}
+	for _, profile := range profiles.Results {
+		if profile.Id != nil {
+			return *profile.Id, nil
+		}
+	}
+	return "", fmt.Errorf("Failed to find central node config profile")
+}
+
+func applyCentralNodeConfigProfile(d *schema.ResourceData, m interface{}, id string) error {
+	client := central_config.NewNodeConfigProfilesClient(getPolicyConnector(m))
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return err
+	}
+
+	ntpServers := interface2StringList(d.Get("ntp_servers").([]interface{}))
+	if len(ntpServers) > 0 {
+		obj.Ntp = &nsxModel.NtpProperties{
+			Servers: ntpServers,
+		}
+	}
+	timezone := d.Get("timezone").(string)
+	if timezone != "" {
+		obj.Timezone = &timezone
+	}
+	if obj.Snmp == nil {
+		obj.Snmp = &nsxModel.SnmpProperties{}
+	}
+	obj.Snmp.V3 = getCentralNodeConfigSnmpV3FromSchema(d)
+
+	_, err = client.Update(id, obj)
+	return err
+}
+
+func resourceNsxtCentralNodeConfigProfileCreate(d *schema.ResourceData, m interface{}) error {
+	id, err := getCentralNodeConfigProfileID(d, m)
+	if err != nil {
+		return handleCreateError("CentralNodeConfigProfile", "", err)
+	}
+
+	log.Printf("[INFO] Applying central node config profile %s", id)
+	err = applyCentralNodeConfigProfile(d, m, id)
+	if err != nil {
+		return handleCreateError("CentralNodeConfigProfile", id, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtCentralNodeConfigProfileRead(d, m)
+}
+
+// NSX pushes the profile to all nodes, however nodes with local override can
+// deviate from it. Settings of such manager node are stored in state, so that
+// drift is detected.
+func resourceNsxtCentralNodeConfigProfileRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CentralNodeConfigProfile ID")
+	}
+
+	client := central_config.NewNodeConfigProfilesClient(getPolicyConnector(m))
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "CentralNodeConfigProfile", id, err)
+	}
+
+	var ntpServers []string
+	if obj.Ntp != nil {
+		ntpServers = obj.Ntp.Servers
+	}
+	timezone := ""
+	if obj.Timezone != nil {
+		timezone = *obj.Timezone
+	}
+
+	nodes, err := getManagerNodeConnectors(m)
+	if err != nil {
+		return handleReadError(d, "CentralNodeConfigProfile", id, err)
+	}
+	ntpDrift := false
+	timezoneDrift := false
+	for _, node := range nodes {
+		ntpClient := services.NewNtpClient(node.connector)
+		nodeNtp, err := ntpClient.Get()
+		if err != nil {
+			return handleReadError(d, "CentralNodeConfigProfile", id, err)
+		}
+		var nodeNtpServers []string
+		if nodeNtp.ServiceProperties != nil {
+			nodeNtpServers = nodeNtp.ServiceProperties.Servers
+		}
+		if !ntpDrift && !managerNodeSettingsListsEqual(ntpServers, nodeNtpServers) {
+			log.Printf("[DEBUG] NTP servers on manager node %s differ from profile %s", node.id, id)
+			ntpServers = nodeNtpServers
+			ntpDrift = true
+		}
+
+		nodeClient := nsx.NewNodeClient(node.connector)
+		nodeProperties, err := nodeClient.Get()
+		if err != nil {
+			return handleReadError(d, "CentralNodeConfigProfile", id, err)
+		}
+		if !timezoneDrift && timezone != "" && nodeProperties.Timezone != nil && *nodeProperties.Timezone != timezone {
+			log.Printf("[DEBUG] Timezone on manager node %s differs from profile %s", node.id, id)
+			timezone = *nodeProperties.Timezone
+			timezoneDrift = true
+		}
+	}
+
+	d.Set("profile_id", id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("ntp_servers", ntpServers)
+	d.Set("timezone", timezone)
+	var snmpV3 *nsxModel.Snmpv3Properties
+	if obj.Snmp != nil {
+		snmpV3 = obj.Snmp.V3
+	}
+	setCentralNodeConfigSnmpV3InSchema(d, snmpV3)
+
+	return nil
+}
+
+func resourceNsxtCentralNodeConfigProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	err := applyCentralNodeConfigProfile(d, m, id)
+	if err != nil {
+		return handleUpdateError("CentralNodeConfigProfile", id, err)
+	}
+
+	return resourceNsxtCentralNodeConfigProfileRead(d, m)
+}
+
+// Profile is built-in and can not be deleted, hence SNMP v3 settings are cleared.
+// NTP servers and timezone might not be managed by this resource, and are left intact.
+func resourceNsxtCentralNodeConfigProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	client := central_config.NewNodeConfigProfilesClient(getPolicyConnector(m))
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return handleDeleteError("CentralNodeConfigProfile", id, err)
+	}
+
+	if obj.Snmp != nil {
+		obj.Snmp.V3 = nil
+	}
+	_, err = client.Update(id, obj)
+	if err != nil {
+		return handleDeleteError("CentralNodeConfigProfile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// This test modifies settings of all nodes in the cluster
+func TestAccResourceNsxtCentralNodeConfigProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_central_node_config_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_CLUSTER_NODE")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtCentralNodeConfigProfileTemplate("0.pool.ntp.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "profile_id"),
+					resource.TestCheckResourceAttr(testResourceName, "ntp_servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ntp_servers.0", "0.pool.ntp.org"),
+					resource.TestCheckResourceAttr(testResourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(testResourceName, "snmp_v3.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "snmp_v3.0.user.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "snmp_v3.0.target.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtCentralNodeConfigProfileTemplate("1.pool.ntp.org"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "ntp_servers.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ntp_servers.0", "1.pool.ntp.org"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtCentralNodeConfigProfileTemplate(ntpServer string) string {
+	return fmt.Sprintf(`
+resource "nsxt_central_node_config_profile" "test" {
+  ntp_servers = ["%s"]
+  timezone    = "UTC"
+
+  snmp_v3 {
+    user {
+      user_id       = "tfacc"
+      auth_password = "Auth-Pass-123!"
+      priv_password = "Priv-Pass-123!"
+    }
+
+    target {
+      server  = "192.168.100.10"
+      user_id = "tfacc"
+    }
+  }
+}`, ntpServer)
+}
@@ -0,0 +1,356 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/trust_management"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+var defaultManagerAPICertificateTimeout = 600
+
+const managerAPICertificateClusterID = "cluster"
+
+func resourceNsxtManagerAPICertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtManagerAPICertificateCreate,
+		Read:   resourceNsxtManagerAPICertificateRead,
+		Update: resourceNsxtManagerAPICertificateUpdate,
+		Delete: resourceNsxtManagerAPICertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtManagerAPICertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the certificate to apply",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"certificate_id", "certificate_path"},
+			},
+			"certificate_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the certificate to apply",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the manager node to apply the certificate to. If not specified, certificate is applied to the cluster virtual IP",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Time in seconds to wait for the manager to serve the certificate",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func getManagerAPICertificateServiceType(nodeID string) string {
+	if nodeID == "" {
+		return nsxModel.NodeIdServicesMap_SERVICE_TYPES_MGMT_CLUSTER
+	}
+	return nsxModel.NodeIdServicesMap_SERVICE_TYPES_API
+}
+
+// Policy certificates are realized on manager, possibly with different ID
+func getManagerAPICertificateID(d *schema.ResourceData, m interface{}) (string, error) {
+	certPath := d.Get("certificate_path").(string)
+	if certPath == "" {
+		return d.Get("certificate_id").(string), nil
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCertificatesClient(connector)
+	obj, err := client.Get(getPolicyIDFromPath(certPath), nil)
+	if err != nil {
+		return "", err
+	}
+	if obj.RealizationId != nil && *obj.RealizationId != "" {
+		return *obj.RealizationId, nil
+	}
+	return *obj.Id, nil
+}
+
+func normalizeCertificateThumbprint(thumbprint string) string {
+	return strings.ToLower(strings.ReplaceAll(thumbprint, ":", ""))
+}
+
+func getManagerAPICertificateAddress(m interface{}, nodeID string) (string, error) {
+	if nodeID != "" {
+		connector := getPolicyConnector(m)
+		client := cluster.NewNodesClient(connector)
+		node, err := client.Get(nodeID)
+		if err != nil {
+			return "", err
+		}
+		if node.ApplianceMgmtListenAddr == nil || *node.ApplianceMgmtListenAddr == "" {
+			return "", fmt.Errorf("Failed to obtain management address of node %s", nodeID)
+		}
+		return net.JoinHostPort(*node.ApplianceMgmtListenAddr, "443"), nil
+	}
+
+	// Cluster certificate is served on cluster virtual IP
+	client := cluster.NewApiVirtualIpClient(getPolicyConnector(m))
+	vip, err := client.Get()
+	if err != nil {
+		return "", err
+	}
+	if vip.IpAddress != nil && *vip.IpAddress != "" && *vip.IpAddress != DefaultIPv4VirtualAddress {
+		return net.JoinHostPort(*vip.IpAddress, "443"), nil
+	}
+	if vip.Ip6Address != nil && *vip.Ip6Address != "" && *vip.Ip6Address != DefaultIPv6VirtualAddress {
+		return net.JoinHostPort(*vip.Ip6Address, "443"), nil
+	}
+	return "", fmt.Errorf("Cluster virtual IP is not configured, node_id must be specified")
+}
+
+// Retrieve thumbprint of the leaf certificate served on address. Verification is skipped
+// since the served certificate is only compared against the expected thumbprint.
+func getServedCertificateThumbprint(address string) (string, error) {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", fmt.Errorf("No certificate served on %s", address)
+	}
+	sum := sha256.Sum256(certs[0].Raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func isManagerAPICertificateUsedBy(cert nsxModel.Certificate, nodeID string, serviceType string) bool {
+	for _, usedBy := range cert.UsedBy {
+		if nodeID != "" && (usedBy.NodeId == nil || *usedBy.NodeId != nodeID) {
+			continue
+		}
+		if stringInList(serviceType, usedBy.ServiceTypes) {
+			return true
+		}
+	}
+	return false
+}
+
+// Look up the certificate currently served for the node or cluster, used on import
+func getManagerAPICertificateInUse(client trust_management.CertificatesClient, nodeID string, serviceType string) (string, error) {
+	var cursor *string
+	for {
+		certList, err := client.List(cursor, nil, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return "", err
+		}
+		for _, cert := range certList.Results {
+			if cert.Id != nil && isManagerAPICertificateUsedBy(cert, nodeID, serviceType) {
+				return *cert.Id, nil
+			}
+		}
+		cursor = certList.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			break
+		}
+	}
+
+	return "", nil
+}
+
+func applyManagerAPICertificate(d *schema.ResourceData, m interface{}, certID string) error {
+	connector := getPolicyConnector(m)
+	client := trust_management.NewCertificatesClient(connector)
+	nodeID := d.Get("node_id").(string)
+	serviceType := getManagerAPICertificateServiceType(nodeID)
+
+	usage := "SERVER"
+	status, err := client.Validate(certID, &usage)
+	if err != nil {
+		return err
+	}
+	if status.Status == nil || *status.Status != nsxModel.CertificateCheckingStatus_STATUS_OK {
+		errorMessage := ""
+		if status.ErrorMessage != nil {
+			errorMessage = *status.ErrorMessage
+		}
+		return fmt.Errorf("Certificate %s failed validation: %v %s", certID, status.Status, errorMessage)
+	}
+
+	cert, err := client.Get(certID, nil)
+	if err != nil {
+		return err
+	}
+	if cert.LeafCertificateSha256Thumbprint == nil {
+		return fmt.Errorf("Failed to obtain thumbprint of certificate %s", certID)
+	}
+	expectedThumbprint := normalizeCertificateThumbprint(*cert.LeafCertificateSha256Thumbprint)
+
+	address, err := getManagerAPICertificateAddress(m, nodeID)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Applying certificate %s for service %s", certID, serviceType)
+	var nodeIDPtr *string
+	if nodeID != "" {
+		nodeIDPtr = &nodeID
+	}
+	err = client.Applycertificate(certID, serviceType, nodeIDPtr)
+	if err != nil {
+		return err
+	}
+
+	// Connections established before the swap are bound to the old certificate
+	httpClient := m.(nsxtClients).PolicyHTTPClient
+	if httpClient != nil {
+		httpClient.CloseIdleConnections()
+	}
+
+	timeout := d.Get("timeout").(int)
+	if timeout == 0 {
+		timeout = defaultManagerAPICertificateTimeout
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"served"},
+		Refresh: func() (interface{}, string, error) {
+			thumbprint, err := getServedCertificateThumbprint(address)
+			if err != nil {
+				// API service might be restarting
+				log.Printf("[DEBUG] Failed to retrieve certificate served on %s: %v", address, err)
+				return address, "pending", nil
+			}
+			if thumbprint != expectedThumbprint {
+				return address, "pending", nil
+			}
+			return address, "served", nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 5 * time.Second,
+		Delay:      5 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Failed to wait for certificate %s to be served on %s: %v", certID, address, err)
+	}
+
+	d.Set("certificate_id", certID)
+	return nil
+}
+
+func resourceNsxtManagerAPICertificateCreate(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("node_id").(string)
+	if id == "" {
+		id = managerAPICertificateClusterID
+	}
+
+	certID, err := getManagerAPICertificateID(d, m)
+	if err != nil {
+		return handleCreateError("ManagerAPICertificate", id, err)
+	}
+
+	err = applyManagerAPICertificate(d, m, certID)
+	if err != nil {
+		return handleCreateError("ManagerAPICertificate", id, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtManagerAPICertificateRead(d, m)
+}
+
+func resourceNsxtManagerAPICertificateRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining ManagerAPICertificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := trust_management.NewCertificatesClient(connector)
+	certID := d.Get("certificate_id").(string)
+	nodeID := d.Get("node_id").(string)
+	serviceType := getManagerAPICertificateServiceType(nodeID)
+
+	if certID == "" {
+		// Imported resource
+		certID, err := getManagerAPICertificateInUse(client, nodeID, serviceType)
+		if err != nil {
+			return handleReadError(d, "ManagerAPICertificate", id, err)
+		}
+		if certID == "" {
+			return fmt.Errorf("Failed to find certificate used for service %s", serviceType)
+		}
+		d.Set("certificate_id", certID)
+		return nil
+	}
+
+	cert, err := client.Get(certID, nil)
+	if err != nil {
+		return handleReadError(d, "ManagerAPICertificate", id, err)
+	}
+
+	if isManagerAPICertificateUsedBy(cert, nodeID, serviceType) {
+		return nil
+	}
+
+	// Certificate was replaced outside of terraform
+	log.Printf("[DEBUG] Certificate %s is no longer used for service %s", certID, serviceType)
+	d.SetId("")
+	return nil
+}
+
+func resourceNsxtManagerAPICertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if d.HasChanges("certificate_id", "certificate_path") {
+		certID, err := getManagerAPICertificateID(d, m)
+		if err != nil {
+			return handleUpdateError("ManagerAPICertificate", id, err)
+		}
+
+		// After import, certificate_path might point to the certificate already in use
+		oldCertID, _ := d.GetChange("certificate_id")
+		if certID != oldCertID.(string) {
+			err = applyManagerAPICertificate(d, m, certID)
+			if err != nil {
+				return handleUpdateError("ManagerAPICertificate", id, err)
+			}
+		} else {
+			d.Set("certificate_id", certID)
+		}
+	}
+
+	return resourceNsxtManagerAPICertificateRead(d, m)
+}
+
+func resourceNsxtManagerAPICertificateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if id != managerAPICertificateClusterID {
+		d.Set("node_id", id)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNsxtManagerAPICertificateDelete(d *schema.ResourceData, m interface{}) error {
+	// Manager always serves a certificate, hence there is nothing to unassign
+	log.Printf("[INFO] Certificate assignment %s is removed from state only", d.Id())
+	return nil
+}
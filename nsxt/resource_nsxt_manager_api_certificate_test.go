@@ -0,0 +1,92 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// This test replaces API certificate of the manager node, and thus requires
+// a certificate trusted by the test client
+func TestAccResourceNsxtManagerAPICertificate_node(t *testing.T) {
+	testResourceName := "nsxt_manager_api_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "4.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_CLUSTER_NODE")
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_CERTIFICATE_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerAPICertificateNodeTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "certificate_id", getTestManagerCertificateID()),
+					resource.TestCheckResourceAttrSet(testResourceName, "node_id"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// This test replaces API certificate of the cluster virtual IP, which needs to be
+// configured on the cluster
+func TestAccResourceNsxtManagerAPICertificate_cluster(t *testing.T) {
+	testResourceName := "nsxt_manager_api_certificate.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "4.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_CLUSTER_CERTIFICATE_ID")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerAPICertificateClusterTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "certificate_id", getTestManagerClusterCertificateID()),
+					resource.TestCheckResourceAttr(testResourceName, "id", managerAPICertificateClusterID),
+					resource.TestCheckResourceAttr(testResourceName, "node_id", ""),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerAPICertificateNodeTemplate() string {
+	return fmt.Sprintf(`
+data "nsxt_manager_cluster_node" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_manager_api_certificate" "test" {
+  certificate_id = "%s"
+  node_id        = data.nsxt_manager_cluster_node.test.id
+}`, getTestManagerClusterNode(), getTestManagerCertificateID())
+}
+
+func testAccNsxtManagerAPICertificateClusterTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_api_certificate" "test" {
+  certificate_id = "%s"
+}`, getTestManagerClusterCertificateID())
+}
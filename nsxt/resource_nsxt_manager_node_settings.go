@@ -0,0 +1,476 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/node/network"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/node/services/syslog"
+)
+
+var managerNodeSyslogProtocolValues = []string{
+	nsxModel.NodeSyslogExporterProperties_PROTOCOL_TCP,
+	nsxModel.NodeSyslogExporterProperties_PROTOCOL_TLS,
+	nsxModel.NodeSyslogExporterProperties_PROTOCOL_UDP,
+	nsxModel.NodeSyslogExporterProperties_PROTOCOL_LI,
+	nsxModel.NodeSyslogExporterProperties_PROTOCOL_LI_TLS,
+}
+
+var managerNodeSyslogLevelValues = []string{
+	nsxModel.NodeSyslogExporterProperties_LEVEL_EMERG,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_ALERT,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_CRIT,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_ERR,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_WARNING,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_NOTICE,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_INFO,
+	nsxModel.NodeSyslogExporterProperties_LEVEL_DEBUG,
+}
+
+// PEM attributes of syslog exporter are not fully returned by NSX, hence
+// they are not compared with the node configuration
+var managerNodeSyslogPemAttributes = []string{"tls_ca_pem", "tls_cert_pem", "tls_key_pem", "tls_client_ca_pem"}
+
+func resourceNsxtManagerNodeSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtManagerNodeSettingsCreate,
+		Read:   resourceNsxtManagerNodeSettingsRead,
+		Update: resourceNsxtManagerNodeSettingsUpdate,
+		Delete: resourceNsxtManagerNodeSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name_servers": {
+				Type:        schema.TypeList,
+				Description: "Name servers configured on each manager node",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateSingleIP(),
+				},
+			},
+			"search_domains": {
+				Type:        schema.TypeList,
+				Description: "Search domains configured on each manager node",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"syslog_exporter": {
+				Type:        schema.TypeSet,
+				Description: "Syslog exporters configured on each manager node",
+				Optional:    true,
+				Computed:    true,
+				Elem:        getManagerNodeSyslogExporterSchema(),
+			},
+			"node_ids": {
+				Type:        schema.TypeList,
+				Description: "IDs of manager nodes the settings are applied to",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func getManagerNodeSyslogExporterSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"exporter_name": {
+				Type:        schema.TypeString,
+				Description: "Unique name of the exporter",
+				Required:    true,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Description: "IP address or hostname of server to export to",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Port to export to",
+				Optional:     true,
+				Default:      514,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Export protocol",
+				Optional:     true,
+				Default:      nsxModel.NodeSyslogExporterProperties_PROTOCOL_TCP,
+				ValidateFunc: validation.StringInSlice(managerNodeSyslogProtocolValues, false),
+			},
+			"level": {
+				Type:         schema.TypeString,
+				Description:  "Logging level to export",
+				Optional:     true,
+				Default:      nsxModel.NodeSyslogExporterProperties_LEVEL_INFO,
+				ValidateFunc: validation.StringInSlice(managerNodeSyslogLevelValues, false),
+			},
+			"tls_ca_pem": {
+				Type:        schema.TypeString,
+				Description: "CA certificate PEM of TLS server to export to",
+				Optional:    true,
+			},
+			"tls_cert_pem": {
+				Type:        schema.TypeString,
+				Description: "Certificate PEM of the syslog client",
+				Optional:    true,
+			},
+			"tls_key_pem": {
+				Type:        schema.TypeString,
+				Description: "Private key PEM of the syslog client",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"tls_client_ca_pem": {
+				Type:        schema.TypeString,
+				Description: "CA certificate PEM of the syslog client",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+type managerNodeConnector struct {
+	id        string
+	connector client.Connector
+}
+
+// Node settings are local to each manager node, hence node API is invoked on
+// every manager node directly, using provider credentials
+func getManagerNodeConnectors(m interface{}) ([]managerNodeConnector, error) {
+	nodesClient := cluster.NewNodesClient(getPolicyConnector(m))
+	nodes, err := nodesClient.List(nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := m.(nsxtClients)
+	username, password := getHostCredential(m)
+	var result []managerNodeConnector
+	for _, node := range nodes.Results {
+		if node.ManagerRole == nil || node.Id == nil {
+			continue
+		}
+		if node.ApplianceMgmtListenAddr == nil || *node.ApplianceMgmtListenAddr == "" {
+			return nil, fmt.Errorf("Failed to obtain management address of node %s", *node.Id)
+		}
+		host := fmt.Sprintf("https://%s", net.JoinHostPort(*node.ApplianceMgmtListenAddr, "443"))
+		nodeClients := nsxtClients{
+			CommonConfig: c.CommonConfig,
+		}
+		err = configureNewClient(&nodeClients, &c, host, username, password)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, managerNodeConnector{
+			id:        *node.Id,
+			connector: getStandalonePolicyConnector(nodeClients, true),
+		})
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("Failed to find manager nodes in the cluster")
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].id < result[j].id
+	})
+	return result, nil
+}
+
+func getManagerNodeSyslogExporterFromSchema(data map[string]interface{}) nsxModel.NodeSyslogExporterProperties {
+	exporterName := data["exporter_name"].(string)
+	server := data["server"].(string)
+	port := int64(data["port"].(int))
+	protocol := data["protocol"].(string)
+	level := data["level"].(string)
+	exporter := nsxModel.NodeSyslogExporterProperties{
+		ExporterName: &exporterName,
+		Server:       &server,
+		Port:         &port,
+		Protocol:     &protocol,
+		Level:        &level,
+	}
+
+	if value := data["tls_ca_pem"].(string); value != "" {
+		exporter.TlsCaPem = &value
+	}
+	if value := data["tls_cert_pem"].(string); value != "" {
+		exporter.TlsCertPem = &value
+	}
+	if value := data["tls_key_pem"].(string); value != "" {
+		exporter.TlsKeyPem = &value
+	}
+	if value := data["tls_client_ca_pem"].(string); value != "" {
+		exporter.TlsClientCaPem = &value
+	}
+	return exporter
+}
+
+// Convert exporter to schema, with PEM attributes taken from configuration
+func setManagerNodeSyslogExporterInSchema(exporter nsxModel.NodeSyslogExporterProperties, configured map[string]map[string]interface{}) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["exporter_name"] = *exporter.ExporterName
+	elem["server"] = ""
+	if exporter.Server != nil {
+		elem["server"] = *exporter.Server
+	}
+	elem["port"] = 0
+	if exporter.Port != nil {
+		elem["port"] = int(*exporter.Port)
+	}
+	elem["protocol"] = ""
+	if exporter.Protocol != nil {
+		elem["protocol"] = *exporter.Protocol
+	}
+	elem["level"] = ""
+	if exporter.Level != nil {
+		elem["level"] = *exporter.Level
+	}
+	for _, attr := range managerNodeSyslogPemAttributes {
+		elem[attr] = ""
+		if data, ok := configured[*exporter.ExporterName]; ok {
+			elem[attr] = data[attr]
+		}
+	}
+	return elem
+}
+
+func getManagerNodeSyslogExportersMap(exporters []interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	for _, exporter := range exporters {
+		data := exporter.(map[string]interface{})
+		result[data["exporter_name"].(string)] = data
+	}
+	return result
+}
+
+func managerNodeSettingsListsEqual(list1 []string, list2 []string) bool {
+	if len(list1) == 0 && len(list2) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(list1, list2)
+}
+
+// Exporters removed from configuration are deleted, while exporters not managed
+// by terraform are left intact
+func applyManagerNodeSyslogExporters(d *schema.ResourceData, connector client.Connector, nodeID string) error {
+	if d.GetRawConfig().GetAttr("syslog_exporter").IsNull() {
+		return nil
+	}
+
+	client := syslog.NewExportersClient(connector)
+	oldExporters, newExporters := d.GetChange("syslog_exporter")
+	oldMap := getManagerNodeSyslogExportersMap(oldExporters.(*schema.Set).List())
+	newMap := getManagerNodeSyslogExportersMap(newExporters.(*schema.Set).List())
+
+	existing, err := client.List()
+	if err != nil {
+		return err
+	}
+
+	existingMap := make(map[string]map[string]interface{})
+	for _, exporter := range existing.Results {
+		if exporter.ExporterName == nil {
+			continue
+		}
+		name := *exporter.ExporterName
+		elem := setManagerNodeSyslogExporterInSchema(exporter, newMap)
+		data, configured := newMap[name]
+		if configured && reflect.DeepEqual(elem, data) {
+			if oldData, ok := oldMap[name]; !ok || reflect.DeepEqual(oldData, data) {
+				existingMap[name] = elem
+				continue
+			}
+		}
+		if _, managed := oldMap[name]; !configured && !managed {
+			continue
+		}
+
+		// Exporters can not be modified, hence changed exporter is re-created
+		log.Printf("[INFO] Deleting syslog exporter %s on manager node %s", name, nodeID)
+		err = client.Delete0(name)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, data := range newMap {
+		if _, ok := existingMap[name]; ok {
+			continue
+		}
+		log.Printf("[INFO] Creating syslog exporter %s on manager node %s", name, nodeID)
+		_, err = client.Create(getManagerNodeSyslogExporterFromSchema(data))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyManagerNodeSettings(d *schema.ResourceData, m interface{}) error {
+	nodes, err := getManagerNodeConnectors(m)
+	if err != nil {
+		return err
+	}
+
+	nameServers := interface2StringList(d.Get("name_servers").([]interface{}))
+	searchDomains := interface2StringList(d.Get("search_domains").([]interface{}))
+	for _, node := range nodes {
+		if len(nameServers) > 0 {
+			log.Printf("[INFO] Updating name servers on manager node %s", node.id)
+			client := network.NewNameServersClient(node.connector)
+			_, err = client.Update(nsxModel.NodeNameServersProperties{NameServers: nameServers})
+			if err != nil {
+				return fmt.Errorf("Failed to update name servers on manager node %s: %v", node.id, err)
+			}
+		}
+
+		if len(searchDomains) > 0 {
+			log.Printf("[INFO] Updating search domains on manager node %s", node.id)
+			client := network.NewSearchDomainsClient(node.connector)
+			_, err = client.Update(nsxModel.NodeSearchDomainsProperties{SearchDomains: searchDomains})
+			if err != nil {
+				return fmt.Errorf("Failed to update search domains on manager node %s: %v", node.id, err)
+			}
+		}
+
+		err = applyManagerNodeSyslogExporters(d, node.connector, node.id)
+		if err != nil {
+			return fmt.Errorf("Failed to update syslog exporters on manager node %s: %v", node.id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtManagerNodeSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	client := nsx.NewClusterClient(getPolicyConnector(m))
+	clusterConfig, err := client.Get()
+	if err != nil {
+		return handleCreateError("ManagerNodeSettings", "", err)
+	}
+	id := *clusterConfig.ClusterId
+
+	err = applyManagerNodeSettings(d, m)
+	if err != nil {
+		return handleCreateError("ManagerNodeSettings", id, err)
+	}
+
+	d.SetId(id)
+	return resourceNsxtManagerNodeSettingsRead(d, m)
+}
+
+// Settings are retrieved from all manager nodes. If any node deviates from
+// configuration, its settings are stored in state, so that drift is detected.
+func resourceNsxtManagerNodeSettingsRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining ManagerNodeSettings ID")
+	}
+
+	nodes, err := getManagerNodeConnectors(m)
+	if err != nil {
+		return handleReadError(d, "ManagerNodeSettings", id, err)
+	}
+
+	nameServers := interface2StringList(d.Get("name_servers").([]interface{}))
+	searchDomains := interface2StringList(d.Get("search_domains").([]interface{}))
+	configuredExporters := d.Get("syslog_exporter").(*schema.Set).List()
+	configuredMap := getManagerNodeSyslogExportersMap(configuredExporters)
+	exporters := configuredExporters
+	nameServersDrift := false
+	searchDomainsDrift := false
+	exportersDrift := false
+	var nodeIDs []string
+
+	for _, node := range nodes {
+		nodeIDs = append(nodeIDs, node.id)
+
+		nameServersClient := network.NewNameServersClient(node.connector)
+		nodeNameServers, err := nameServersClient.Get()
+		if err != nil {
+			return handleReadError(d, "ManagerNodeSettings", id, err)
+		}
+		if !nameServersDrift && !managerNodeSettingsListsEqual(nameServers, nodeNameServers.NameServers) {
+			log.Printf("[DEBUG] Name servers on manager node %s differ from configuration", node.id)
+			nameServers = nodeNameServers.NameServers
+			nameServersDrift = true
+		}
+
+		searchDomainsClient := network.NewSearchDomainsClient(node.connector)
+		nodeSearchDomains, err := searchDomainsClient.Get()
+		if err != nil {
+			return handleReadError(d, "ManagerNodeSettings", id, err)
+		}
+		if !searchDomainsDrift && !managerNodeSettingsListsEqual(searchDomains, nodeSearchDomains.SearchDomains) {
+			log.Printf("[DEBUG] Search domains on manager node %s differ from configuration", node.id)
+			searchDomains = nodeSearchDomains.SearchDomains
+			searchDomainsDrift = true
+		}
+
+		exportersClient := syslog.NewExportersClient(node.connector)
+		nodeExporters, err := exportersClient.List()
+		if err != nil {
+			return handleReadError(d, "ManagerNodeSettings", id, err)
+		}
+		var nodeExporterList []interface{}
+		for _, exporter := range nodeExporters.Results {
+			if exporter.ExporterName == nil {
+				continue
+			}
+			nodeExporterList = append(nodeExporterList, setManagerNodeSyslogExporterInSchema(exporter, configuredMap))
+		}
+		if !exportersDrift && !reflect.DeepEqual(configuredMap, getManagerNodeSyslogExportersMap(nodeExporterList)) {
+			log.Printf("[DEBUG] Syslog exporters on manager node %s differ from configuration", node.id)
+			exporters = nodeExporterList
+			exportersDrift = true
+		}
+	}
+
+	d.Set("name_servers", nameServers)
+	d.Set("search_domains", searchDomains)
+	d.Set("syslog_exporter", exporters)
+	d.Set("node_ids", nodeIDs)
+
+	return nil
+}
+
+func resourceNsxtManagerNodeSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	err := applyManagerNodeSettings(d, m)
+	if err != nil {
+		return handleUpdateError("ManagerNodeSettings", id, err)
+	}
+
+	return resourceNsxtManagerNodeSettingsRead(d, m)
+}
+
+// Settings are left intact on manager nodes, since nodes can not operate without
+// name servers, and syslog exporters might not be managed by terraform
+func resourceNsxtManagerNodeSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Manager node settings %s are removed from state only", d.Id())
+	return nil
+}
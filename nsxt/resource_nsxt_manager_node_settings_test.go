@@ -0,0 +1,76 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// This test modifies settings of all manager nodes in the cluster
+func TestAccResourceNsxtManagerNodeSettings_basic(t *testing.T) {
+	testResourceName := "nsxt_manager_node_settings.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccEnvDefined(t, "NSXT_TEST_MANAGER_CLUSTER_NODE")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtManagerNodeSettingsTemplate("syslog1.example.com", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.0", "example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "syslog_exporter.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "node_ids.#"),
+				),
+			},
+			{
+				Config: testAccNsxtManagerNodeSettingsTemplate("syslog2.example.com", "WARNING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "syslog_exporter.#", "1"),
+				),
+			},
+			{
+				// Exporters are not modified when not specified
+				Config: testAccNsxtManagerNodeSettingsWithoutExportersTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "search_domains.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "syslog_exporter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(testResourceName, "syslog_exporter.*", map[string]string{
+						"exporter_name": "tfacc-test",
+						"server":        "syslog2.example.com",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtManagerNodeSettingsTemplate(server string, level string) string {
+	return fmt.Sprintf(`
+resource "nsxt_manager_node_settings" "test" {
+  search_domains = ["example.com"]
+
+  syslog_exporter {
+    exporter_name = "tfacc-test"
+    server        = "%s"
+    protocol      = "UDP"
+    level         = "%s"
+  }
+}`, server, level)
+}
+
+func testAccNsxtManagerNodeSettingsWithoutExportersTemplate() string {
+	return `
+resource "nsxt_manager_node_settings" "test" {
+  search_domains = ["example.com"]
+}`
+}
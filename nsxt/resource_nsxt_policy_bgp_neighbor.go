@@ -246,9 +246,14 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 	if len(routeFiltering) > 1 && nsxVersionLower("3.0.0") {
 		return neighborStruct, fmt.Errorf("Only 1 element for 'route_filtering' is supported with NSX-T versions up to 3.0.0")
 	}
+	addressFamilies := make(map[string]bool)
 	for _, filter := range routeFiltering {
 		data := filter.(map[string]interface{})
 		addrFamily := data["address_family"].(string)
+		if addressFamilies[addrFamily] {
+			return neighborStruct, fmt.Errorf("'route_filtering' for address family %s is specified more than once", addrFamily)
+		}
+		addressFamilies[addrFamily] = true
 		if addrFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN && nsxVersionLower("3.0.0") {
 			return neighborStruct, fmt.Errorf("'%s' is not supported for 'address_family' with NSX-T versions less than 3.0.0", model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN)
 		}
@@ -303,6 +308,36 @@ func resourceNsxtPolicyBgpNeighborResourceDataToStruct(d *schema.ResourceData, i
 	return neighborStruct, nil
 }
 
+// Prefix lists used as route filters should only contain prefixes of the filter address family
+func validatePolicyBgpNeighborRouteFilters(connector client.Connector, filters []model.BgpRouteFiltering, isGlobalManager bool) error {
+	for _, filter := range filters {
+		if filter.AddressFamily == nil || *filter.AddressFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_L2VPN_EVPN {
+			continue
+		}
+		var filterPaths []string
+		filterPaths = append(filterPaths, filter.InRouteFilters...)
+		filterPaths = append(filterPaths, filter.OutRouteFilters...)
+		for _, filterPath := range filterPaths {
+			if !strings.Contains(filterPath, "/prefix-lists/") {
+				// Route map
+				continue
+			}
+			gwID := getResourceIDFromResourcePath(filterPath, "tier-0s")
+			prefixListID := getPolicyIDFromPath(filterPath)
+			prefixList, err := policyGatewayPrefixListGet(connector, gwID, prefixListID, isGlobalManager)
+			if err != nil {
+				return fmt.Errorf("Failed to retrieve prefix list %s: %v", filterPath, err)
+			}
+			err = validatePrefixEntriesAddressFamily(filterPath, prefixList.Prefixes, *filter.AddressFamily)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceData, m interface{}) error {
 	bgpPath := d.Get("bgp_path").(string)
 	t0ID, serviceID := resourceNsxtPolicyBgpNeighborParseIDs(bgpPath)
@@ -316,6 +351,10 @@ func resourceNsxtPolicyBgpNeighborConvertAndPatch(id string, d *schema.ResourceD
 	}
 
 	connector := getPolicyConnector(m)
+	err = validatePolicyBgpNeighborRouteFilters(connector, obj.RouteFiltering, isPolicyGlobalManager(m))
+	if err != nil {
+		return err
+	}
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating BgpNeighbor with ID %s", id)
 	if isPolicyGlobalManager(m) {
@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -278,7 +279,7 @@ func TestAccResourceNsxtPolicyBgpNeighbor_subConfigPrefixList(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: testAccNsxtPolicyBgpNeighborSubConfigCreatePrefixList(),
+				Config: testAccNsxtPolicyBgpNeighborSubConfigCreatePrefixList("4.4.0.0/20", "20", "23"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccNsxtPolicyBgpNeighborExists(testResourceName),
 					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
@@ -299,6 +300,22 @@ func TestAccResourceNsxtPolicyBgpNeighbor_subConfigPrefixList(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyBgpNeighbor_subConfigPrefixListMismatch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyBgpNeighborCheckDestroy(state, "tfbgp")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyBgpNeighborSubConfigCreatePrefixList("2001:db8::/32", "48", "64"),
+				ExpectError: regexp.MustCompile("can not be used for IPV4 address family"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyBgpNeighbor_importGlobalManager(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_bgp_neighbor.test"
@@ -638,7 +655,7 @@ resource "nsxt_policy_bgp_neighbor" "test" {
 }`, getEdgeClusterName())
 }
 
-func testAccNsxtPolicyBgpNeighborSubConfigCreatePrefixList() string {
+func testAccNsxtPolicyBgpNeighborSubConfigCreatePrefixList(network string, ge string, le string) string {
 	return fmt.Sprintf(`
 data "nsxt_policy_edge_cluster" "EC" {
   display_name = "%s"
@@ -664,9 +681,9 @@ resource "nsxt_policy_gateway_prefix_list" "test" {
 
   prefix {
   	action  = "DENY"
-  	ge      = "20"
-  	le      = "23"
-  	network = "4.4.0.0/20"
+  	ge      = "%s"
+  	le      = "%s"
+  	network = "%s"
   }
 }
 
@@ -687,7 +704,7 @@ resource "nsxt_policy_bgp_neighbor" "test" {
     in_route_filter = nsxt_policy_gateway_prefix_list.test.path
     out_route_filter = nsxt_policy_gateway_prefix_list.test.path
   }
-}`, getEdgeClusterName())
+}`, getEdgeClusterName(), ge, le, network)
 }
 
 func testAccNsxtPolicyBgpNeighborGMTemplate(createFlow bool, subnet string) string {
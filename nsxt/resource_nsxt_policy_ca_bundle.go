@@ -0,0 +1,157 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyCaBundle() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCaBundleCreate,
+		Read:   resourceNsxtPolicyCaBundleRead,
+		Update: resourceNsxtPolicyCaBundleUpdate,
+		Delete: resourceNsxtPolicyCaBundleDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded trusted CA certificates, concatenated",
+				Required:    true,
+			},
+			"earliest_not_after": {
+				Type:        schema.TypeInt,
+				Description: "Earliest expiration time among certificates in the bundle, in epoch milliseconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCaBundleExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCabundlesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving CA Bundle", err)
+}
+
+func resourceNsxtPolicyCaBundlePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+
+	obj := model.CaBundle{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	log.Printf("[INFO] Patching CaBundle with ID %s", id)
+	client := infra.NewCabundlesClient(connector)
+	_, err := client.Patch(id, obj)
+	return err
+}
+
+func resourceNsxtPolicyCaBundleCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCaBundleExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyCaBundlePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("CaBundle", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCaBundleRead(d, m)
+}
+
+func resourceNsxtPolicyCaBundleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CaBundle ID")
+	}
+
+	client := infra.NewCabundlesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "CaBundle", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// NSX may normalize PEM formatting, hence pem_encoded is kept as configured
+	d.Set("earliest_not_after", obj.EarliestNotAfter)
+
+	return nil
+}
+
+func resourceNsxtPolicyCaBundleUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CaBundle ID")
+	}
+
+	err := resourceNsxtPolicyCaBundlePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("CaBundle", id, err)
+	}
+
+	return resourceNsxtPolicyCaBundleRead(d, m)
+}
+
+func resourceNsxtPolicyCaBundleDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CaBundle ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCabundlesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("CaBundle", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,430 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	policyCertificateTypeCertificate = "CERTIFICATE"
+	policyCertificateTypeCaBundle    = "CA_BUNDLE"
+	policyCertificateTypeCrl         = "CRL"
+)
+
+var policyCertificateTypeValues = []string{
+	policyCertificateTypeCertificate,
+	policyCertificateTypeCaBundle,
+	policyCertificateTypeCrl,
+}
+
+func resourceNsxtPolicyCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCertificateCreate,
+		Read:   resourceNsxtPolicyCertificateRead,
+		Update: resourceNsxtPolicyCertificateUpdate,
+		Delete: resourceNsxtPolicyCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyCertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the object, certificate with private key, CA certificate bundle or certificate revocation list",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      policyCertificateTypeCertificate,
+				ValidateFunc: validation.StringInSlice(policyCertificateTypeValues, false),
+			},
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate, certificate chain or CRL",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded private key, required for CERTIFICATE type",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Passphrase of the private key",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"key_algo": {
+				Type:        schema.TypeString,
+				Description: "Key algorithm of the private key",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"certificate_type": {
+				Type:        schema.TypeString,
+				Description: "Certificate type as reported by NSX",
+				Computed:    true,
+			},
+			"has_private_key": {
+				Type:        schema.TypeBool,
+				Description: "Whether NSX holds private key for this certificate",
+				Computed:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the certificate or CRL",
+				Computed:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the certificate",
+				Computed:    true,
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Description: "Serial number of the certificate",
+				Computed:    true,
+			},
+			"subject_alt_names": {
+				Type:        schema.TypeList,
+				Description: "Subject alternative names of the certificate",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"not_before": {
+				Type:        schema.TypeInt,
+				Description: "Start of the certificate validity, in epoch milliseconds",
+				Computed:    true,
+			},
+			"not_after": {
+				Type:        schema.TypeInt,
+				Description: "Expiration time of the certificate, in epoch milliseconds",
+				Computed:    true,
+			},
+			"next_update": {
+				Type:        schema.TypeString,
+				Description: "Next update time of the CRL",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCertificateExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCertificatesClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Certificate", err)
+}
+
+func resourceNsxtPolicyCrlExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCrlsClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving CRL", err)
+}
+
+// Subject alternative names are not reported by NSX, hence they are parsed from the
+// first certificate in PEM
+func getSubjectAltNamesFromPem(pemEncoded string) []string {
+	var names []string
+	block, _ := pem.Decode([]byte(pemEncoded))
+	if block == nil {
+		return names
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		log.Printf("[WARNING] Failed to parse certificate for subject alternative names: %v", err)
+		return names
+	}
+
+	names = append(names, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	names = append(names, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+
+	return names
+}
+
+func setPolicyCertificateDetailsInSchema(d *schema.ResourceData, details []model.X509Certificate) {
+	// First certificate in the chain is the leaf certificate
+	if len(details) > 0 {
+		leaf := details[0]
+		d.Set("issuer", leaf.Issuer)
+		d.Set("subject", leaf.Subject)
+		d.Set("serial_number", leaf.SerialNumber)
+		d.Set("not_before", leaf.NotBefore)
+		d.Set("not_after", leaf.NotAfter)
+	}
+}
+
+func resourceNsxtPolicyCertificatePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	certType := d.Get("type").(string)
+
+	if certType == policyCertificateTypeCrl {
+		crlType := model.TlsCrl_CRL_TYPE_X509
+		obj := model.TlsCrl{
+			DisplayName: &displayName,
+			Description: &description,
+			Tags:        tags,
+			PemEncoded:  &pemEncoded,
+			CrlType:     &crlType,
+		}
+
+		log.Printf("[INFO] Patching CRL with ID %s", id)
+		client := infra.NewCrlsClient(connector)
+		return client.Patch(id, obj)
+	}
+
+	obj := model.TlsTrustData{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	privateKey := d.Get("private_key").(string)
+	if len(privateKey) > 0 {
+		obj.PrivateKey = &privateKey
+	}
+	passphrase := d.Get("passphrase").(string)
+	if len(passphrase) > 0 {
+		obj.Passphrase = &passphrase
+	}
+	keyAlgo := d.Get("key_algo").(string)
+	if len(keyAlgo) > 0 {
+		obj.KeyAlgo = &keyAlgo
+	}
+
+	log.Printf("[INFO] Patching Certificate with ID %s", id)
+	client := infra.NewCertificatesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	certType := d.Get("type").(string)
+	privateKey := d.Get("private_key").(string)
+	if certType == policyCertificateTypeCertificate && len(privateKey) == 0 {
+		return fmt.Errorf("private_key is required for %s type", certType)
+	}
+	if certType != policyCertificateTypeCertificate && len(privateKey) > 0 {
+		return fmt.Errorf("private_key is not applicable for %s type", certType)
+	}
+
+	existsFunc := resourceNsxtPolicyCertificateExists
+	if certType == policyCertificateTypeCrl {
+		existsFunc = resourceNsxtPolicyCrlExists
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, existsFunc)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyCertificatePatch(d, m, id)
+	if err != nil {
+		return handleCreateError(certType, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCrlRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+
+	client := infra.NewCrlsClient(connector)
+	details := true
+	obj, err := client.Get(id, &details)
+	if err != nil {
+		return handleReadError(d, "CRL", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("certificate_type", obj.CrlType)
+	d.Set("has_private_key", false)
+
+	if obj.Details != nil {
+		d.Set("issuer", obj.Details.Issuer)
+		d.Set("next_update", obj.Details.NextUpdate)
+	}
+
+	if d.Get("pem_encoded").(string) == "" {
+		// NSX may normalize PEM formatting, hence PEM is only read back on import
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	if d.Get("type").(string) == policyCertificateTypeCrl {
+		return resourceNsxtPolicyCrlRead(d, m)
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	obj, err := client.Get(id, &details)
+	if err != nil {
+		return handleReadError(d, "Certificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("certificate_type", obj.TlsCertificateType)
+	d.Set("has_private_key", obj.HasPrivateKey)
+	if d.Get("type").(string) == "" {
+		// Type is only inferred on import, since it is not reported by NSX
+		if obj.HasPrivateKey != nil && *obj.HasPrivateKey {
+			d.Set("type", policyCertificateTypeCertificate)
+		} else {
+			d.Set("type", policyCertificateTypeCaBundle)
+		}
+	}
+
+	setPolicyCertificateDetailsInSchema(d, obj.Details)
+
+	if d.Get("pem_encoded").(string) == "" {
+		// NSX may normalize PEM formatting, hence PEM is only read back on import
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+	d.Set("subject_alt_names", getSubjectAltNamesFromPem(d.Get("pem_encoded").(string)))
+
+	return nil
+}
+
+func resourceNsxtPolicyCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	err := resourceNsxtPolicyCertificatePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError(d.Get("type").(string), id, err)
+	}
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	if d.Get("type").(string) == policyCertificateTypeCrl {
+		client := infra.NewCrlsClient(connector)
+		err = client.Delete(id)
+	} else {
+		client := infra.NewCertificatesClient(connector)
+		err = client.Delete(id)
+	}
+	if err != nil {
+		return handleDeleteError(d.Get("type").(string), id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyCertificateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporter(d, m)
+	if err != nil {
+		return rd, err
+	}
+
+	if isPolicyPath(importID) {
+		if strings.Contains(importID, "/crls/") {
+			d.Set("type", policyCertificateTypeCrl)
+		}
+		return rd, nil
+	}
+
+	// Certificates and CRLs are stored separately, hence detect type from NSX
+	connector := getPolicyConnector(m)
+	exists, err := resourceNsxtPolicyCertificateExists(d.Id(), connector, false)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		exists, err = resourceNsxtPolicyCrlExists(d.Id(), connector, false)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			d.Set("type", policyCertificateTypeCrl)
+		}
+	}
+
+	return rd, nil
+}
@@ -0,0 +1,325 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Mapping of schema attributes to X500 subject attribute keys, in the order
+// of relative distinguished names in the subject
+var policyCsrSubjectAttributes = []struct {
+	attr string
+	key  string
+}{
+	{"country", "C"},
+	{"state", "ST"},
+	{"locality", "L"},
+	{"organization", "O"},
+	{"organizational_unit", "OU"},
+	{"common_name", "CN"},
+}
+
+var policyCsrKeySizeValues = []int{2048, 3072, 4096}
+
+func resourceNsxtPolicyCertificateSigningRequest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyCertificateSigningRequestCreate,
+		Read:   resourceNsxtPolicyCertificateSigningRequestRead,
+		Delete: resourceNsxtPolicyCertificateSigningRequestDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		// CSR can not be modified once created
+		Schema: map[string]*schema.Schema{
+			"nsx_id": getNsxIDSchema(),
+			"path":   getPathSchema(),
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name for this resource",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description for this resource",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"revision": getRevisionSchema(),
+			"tag":      getTagsSchemaForceNew(),
+			"common_name": {
+				Type:        schema.TypeString,
+				Description: "Common name of the certificate subject",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Description: "Organization of the certificate subject",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"organizational_unit": {
+				Type:        schema.TypeString,
+				Description: "Organizational unit of the certificate subject",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"locality": {
+				Type:        schema.TypeString,
+				Description: "Locality of the certificate subject",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "State or province of the certificate subject",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"country": {
+				Type:         schema.TypeString,
+				Description:  "Two letter country code of the certificate subject",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Description:  "Size of the private key in bits",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2048,
+				ValidateFunc: validation.IntInSlice(policyCsrKeySizeValues),
+			},
+			"is_ca": {
+				Type:        schema.TypeBool,
+				Description: "Whether the request is for a CA certificate",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"pem_encoded": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate signing request",
+				Computed:    true,
+			},
+			"certificate_path": {
+				Type:        schema.TypeString,
+				Description: "Policy path of the certificate issued for this CSR, once the CSR was consumed by NSX",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCertificateSigningRequestExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewCsrsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving CSR", err)
+}
+
+func resourceNsxtPolicyCertificateSigningRequestCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyCertificateSigningRequestExists)
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	algorithm := model.TlsCsr_ALGORITHM_RSA
+	keySize := int64(d.Get("key_size").(int))
+	isCa := d.Get("is_ca").(bool)
+
+	var attributes []model.KeyValue
+	for _, subjectAttr := range policyCsrSubjectAttributes {
+		value := d.Get(subjectAttr.attr).(string)
+		if len(value) == 0 {
+			continue
+		}
+		attrKey := subjectAttr.key
+		attributes = append(attributes, model.KeyValue{
+			Key:   &attrKey,
+			Value: &value,
+		})
+	}
+
+	obj := model.TlsCsr{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Algorithm:   &algorithm,
+		KeySize:     &keySize,
+		IsCa:        &isCa,
+		Subject: &model.Principal{
+			Attributes: attributes,
+		},
+	}
+
+	log.Printf("[INFO] Creating CSR with ID %s", id)
+	client := infra.NewCsrsClient(connector)
+	_, err = client.Create(id, obj)
+	if err != nil {
+		return handleCreateError("CSR", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCertificateSigningRequestRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateSigningRequestRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CSR ID")
+	}
+
+	client := infra.NewCsrsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		pemEncoded := d.Get("pem_encoded").(string)
+		if isNotFoundError(err) && pemEncoded != "" {
+			// NSX deletes the CSR once signed certificate is imported or self-signed.
+			// Keep the resource in state as long as such certificate exists, so that
+			// it does not get recreated.
+			certPath, issuedErr := getPolicyCertificateIssuedForCsr(d, connector, pemEncoded)
+			if issuedErr != nil {
+				return handleReadError(d, "CSR", id, issuedErr)
+			}
+			if certPath != "" {
+				log.Printf("[DEBUG] CSR %s not found, certificate %s was issued for it", id, certPath)
+				d.Set("certificate_path", certPath)
+				return nil
+			}
+		}
+		return handleReadError(d, "CSR", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("key_size", obj.KeySize)
+	d.Set("is_ca", obj.IsCa)
+	d.Set("pem_encoded", obj.PemEncoded)
+
+	if obj.Subject != nil {
+		for _, subjectAttr := range policyCsrSubjectAttributes {
+			for _, kv := range obj.Subject.Attributes {
+				if kv.Key != nil && *kv.Key == subjectAttr.key {
+					d.Set(subjectAttr.attr, kv.Value)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func getPublicKeyFromPem(pemEncoded string, isCsr bool) ([]byte, error) {
+	block, _ := pem.Decode([]byte(pemEncoded))
+	if block == nil {
+		return nil, fmt.Errorf("Failed to decode PEM")
+	}
+	if isCsr {
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return x509.MarshalPKIXPublicKey(csr.PublicKey)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return x509.MarshalPKIXPublicKey(cert.PublicKey)
+}
+
+// Certificate imported or self-signed for the CSR shares its public key. Certificates
+// are only searched once, and path of the certificate found is kept in state.
+func getPolicyCertificateIssuedForCsr(d *schema.ResourceData, connector client.Connector, csrPem string) (string, error) {
+	if certPath := d.Get("certificate_path").(string); certPath != "" {
+		exists, err := resourceNsxtPolicyCertificateExists(getPolicyIDFromPath(certPath), connector, false)
+		if err != nil || !exists {
+			return "", err
+		}
+		return certPath, nil
+	}
+
+	csrKey, err := getPublicKeyFromPem(csrPem, true)
+	if err != nil {
+		return "", err
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	includedFields := "path,pem_encoded"
+	var cursor *string
+	for {
+		certList, err := client.List(cursor, nil, &includedFields, nil, nil, nil, nil, nil)
+		if err != nil {
+			return "", err
+		}
+		for _, cert := range certList.Results {
+			if cert.PemEncoded == nil || cert.Path == nil {
+				continue
+			}
+			certKey, err := getPublicKeyFromPem(*cert.PemEncoded, false)
+			if err != nil {
+				continue
+			}
+			if bytes.Equal(csrKey, certKey) {
+				return *cert.Path, nil
+			}
+		}
+		cursor = certList.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			return "", nil
+		}
+	}
+}
+
+func resourceNsxtPolicyCertificateSigningRequestDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CSR ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCsrsClient(connector)
+	err := client.Delete(id)
+	if err != nil && !isNotFoundError(err) {
+		return handleDeleteError("CSR", id, err)
+	}
+
+	return nil
+}
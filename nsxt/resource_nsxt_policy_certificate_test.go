@@ -0,0 +1,211 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyCertificate_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, keyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotatedCertPem, rotatedKeyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(name, "terraform created", certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform created"),
+					resource.TestCheckResourceAttr(testResourceName, "type", "CERTIFICATE"),
+					resource.TestCheckResourceAttr(testResourceName, "has_private_key", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "certificate_type"),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttr(testResourceName, "subject_alt_names.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "subject_alt_names.0", "acme.example.com"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(updatedName, "terraform updated", certPem, keyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "terraform updated"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				// Rotation replaces the certificate, new one is created before the old one is deleted
+				Config: testAccNsxtPolicyCertificateTemplate(updatedName, "terraform updated", rotatedCertPem, rotatedKeyPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCertificate_caBundle(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, _, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateCaBundleTemplate(name, certPem),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCertificateExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "type", "CA_BUNDLE"),
+					resource.TestCheckResourceAttr(testResourceName, "has_private_key", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCertificate_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_certificate.test"
+	certPem, keyPem, err := testAccGenerateTLSKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCertificateTemplate(name, "", certPem, keyPem),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Certificate resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Certificate resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCertificateExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Certificate %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCertificateCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_certificate" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCertificateExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Certificate %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCertificateTemplate(name string, description string, certPem string, keyPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_certificate" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%sEOT
+  private_key  = <<EOT
+%sEOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}`, name, description, certPem, keyPem)
+}
+
+func testAccNsxtPolicyCertificateCaBundleTemplate(name string, certPem string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_certificate" "test" {
+  display_name = "%s"
+  type         = "CA_BUNDLE"
+  pem_encoded  = <<EOT
+%sEOT
+}`, name, certPem)
+}
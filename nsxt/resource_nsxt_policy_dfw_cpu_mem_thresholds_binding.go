@@ -0,0 +1,165 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDfwCPUMemThresholdsBindingCreate,
+		Read:   resourceNsxtPolicyDfwCPUMemThresholdsBindingRead,
+		Update: resourceNsxtPolicyDfwCPUMemThresholdsBindingUpdate,
+		Delete: resourceNsxtPolicyDfwCPUMemThresholdsBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"profile_path": getPolicyPathSchema(true, false, "Path of CPU memory thresholds profile"),
+			"applied_to": {
+				Type:        schema.TypeSet,
+				Description: "List of group paths where the profile is applied",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Description: "Sequence number used to resolve conflicts when two profiles are applied to a single node. Lower value gets higher precedence",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := firewall.NewCpuMemThresholdsProfileBindingMapsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving DFW CPU Memory Thresholds Binding", err)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	profilePath := d.Get("profile_path").(string)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+
+	obj := model.PolicyFirewallCPUMemThresholdsProfileBindingMap{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		ProfilePath:    &profilePath,
+		AppliedTo:      getStringListFromSchemaSet(d, "applied_to"),
+		SequenceNumber: &sequenceNumber,
+	}
+
+	log.Printf("[INFO] Patching DFW CPU Memory Thresholds Binding with ID %s", id)
+	client := firewall.NewCpuMemThresholdsProfileBindingMapsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDfwCPUMemThresholdsBindingExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyDfwCPUMemThresholdsBindingPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("DFW CPU Memory Thresholds Binding", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDfwCPUMemThresholdsBindingRead(d, m)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Binding ID")
+	}
+
+	client := firewall.NewCpuMemThresholdsProfileBindingMapsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "DFW CPU Memory Thresholds Binding", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("profile_path", obj.ProfilePath)
+	d.Set("applied_to", obj.AppliedTo)
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return nil
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Binding ID")
+	}
+
+	err := resourceNsxtPolicyDfwCPUMemThresholdsBindingPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("DFW CPU Memory Thresholds Binding", id, err)
+	}
+
+	return resourceNsxtPolicyDfwCPUMemThresholdsBindingRead(d, m)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsBindingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Binding ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := firewall.NewCpuMemThresholdsProfileBindingMapsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("DFW CPU Memory Thresholds Binding", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyDfwCPUMemThresholdsBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dfw_cpu_mem_thresholds_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDfwCPUMemThresholdsBindingCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDfwCPUMemThresholdsBindingTemplate(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDfwCPUMemThresholdsBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "10"),
+					resource.TestCheckResourceAttr(testResourceName, "applied_to.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDfwCPUMemThresholdsBindingTemplate(updatedName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyDfwCPUMemThresholdsBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "20"),
+					resource.TestCheckResourceAttr(testResourceName, "applied_to.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDfwCPUMemThresholdsBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dfw_cpu_mem_thresholds_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyDfwCPUMemThresholdsBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDfwCPUMemThresholdsBindingTemplate(name, 10),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDfwCPUMemThresholdsBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy DFW CPU Memory Thresholds Binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy DFW CPU Memory Thresholds Binding resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyDfwCPUMemThresholdsBindingExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy DFW CPU Memory Thresholds Binding %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyDfwCPUMemThresholdsBindingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_dfw_cpu_mem_thresholds_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyDfwCPUMemThresholdsBindingExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy DFW CPU Memory Thresholds Binding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyDfwCPUMemThresholdsBindingTemplate(name string, sequenceNumber int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_dfw_cpu_mem_thresholds_profile" "test" {
+  display_name             = "%s"
+  cpu_threshold_percentage = 80
+  mem_threshold_percentage = 80
+}
+
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_dfw_cpu_mem_thresholds_binding" "test" {
+  display_name    = "%s"
+  profile_path    = nsxt_policy_dfw_cpu_mem_thresholds_profile.test.path
+  applied_to      = [nsxt_policy_group.test.path]
+  sequence_number = %d
+}`, name, name, name, sequenceNumber)
+}
@@ -0,0 +1,163 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDfwCPUMemThresholdsProfileCreate,
+		Read:   resourceNsxtPolicyDfwCPUMemThresholdsProfileRead,
+		Update: resourceNsxtPolicyDfwCPUMemThresholdsProfileUpdate,
+		Delete: resourceNsxtPolicyDfwCPUMemThresholdsProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"cpu_threshold_percentage": {
+				Type:         schema.TypeInt,
+				Description:  "CPU utilization threshold percentage to monitor and report for distributed firewall",
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"mem_threshold_percentage": {
+				Type:         schema.TypeInt,
+				Description:  "Heap memory threshold percentage to monitor and report for distributed firewall",
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving DFW CPU Memory Thresholds Profile", err)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cpuThreshold := int64(d.Get("cpu_threshold_percentage").(int))
+	memThreshold := int64(d.Get("mem_threshold_percentage").(int))
+
+	obj := model.PolicyFirewallCpuMemThresholdsProfile{
+		DisplayName:            &displayName,
+		Description:            &description,
+		Tags:                   tags,
+		CpuThresholdPercentage: &cpuThreshold,
+		MemThresholdPercentage: &memThreshold,
+	}
+
+	log.Printf("[INFO] Patching DFW CPU Memory Thresholds Profile with ID %s", id)
+	client := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDfwCPUMemThresholdsProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyDfwCPUMemThresholdsProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("DFW CPU Memory Thresholds Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDfwCPUMemThresholdsProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Profile ID")
+	}
+
+	client := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "DFW CPU Memory Thresholds Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("cpu_threshold_percentage", obj.CpuThresholdPercentage)
+	d.Set("mem_threshold_percentage", obj.MemThresholdPercentage)
+
+	return nil
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Profile ID")
+	}
+
+	err := resourceNsxtPolicyDfwCPUMemThresholdsProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("DFW CPU Memory Thresholds Profile", id, err)
+	}
+
+	return resourceNsxtPolicyDfwCPUMemThresholdsProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDfwCPUMemThresholdsProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DFW CPU Memory Thresholds Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := firewall.NewCpuMemThresholdsProfilesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("DFW CPU Memory Thresholds Profile", id, err)
+	}
+
+	return nil
+}
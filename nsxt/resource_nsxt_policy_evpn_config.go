@@ -103,6 +103,14 @@ func patchNsxtPolicyEvpnConfig(connector client.Connector, d *schema.ResourceDat
 		mode := d.Get("mode").(string)
 		encapConfig := model.EvpnEncapConfig{}
 
+		if mode == model.EvpnConfig_MODE_INLINE && len(vniPoolPath) == 0 {
+			return fmt.Errorf("vni_pool_path is required for %s mode", mode)
+		}
+
+		if mode == model.EvpnConfig_MODE_ROUTE_SERVER && len(evpnTenantPath) == 0 {
+			return fmt.Errorf("evpn_tenant_path is required for %s mode", mode)
+		}
+
 		if len(vniPoolPath) > 0 {
 			encapConfig.VniPoolPath = &vniPoolPath
 		}
@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -125,6 +126,24 @@ func TestAccResourceNsxtPolicyEvpnConfig_routeServer(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyEvpnConfig_routeServerNoTenant(t *testing.T) {
+	displayName := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.1.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyEvpnConfigCheckDestroy(state, displayName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyEvpnConfigRouteServerNoTenant(displayName),
+				ExpectError: regexp.MustCompile("evpn_tenant_path is required"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyEvpnConfig_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_evpn_config.test"
@@ -255,6 +274,18 @@ resource "nsxt_policy_evpn_config" "test" {
 }`, accTestEvpnConfigHelperName, displayName, description)
 }
 
+func testAccNsxtPolicyEvpnConfigRouteServerNoTenant(displayName string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier0WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+
+resource "nsxt_policy_evpn_config" "test" {
+  gateway_path = nsxt_policy_tier0_gateway.test.path
+  display_name = "%s"
+
+  mode = "ROUTE_SERVER"
+}`, displayName)
+}
+
 func testAccNSXPolicyEvpnConfigIDGenerator(testResourceName string) func(*terraform.State) (string, error) {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[testResourceName]
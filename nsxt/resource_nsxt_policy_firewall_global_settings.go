@@ -0,0 +1,166 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Firewall settings is a singleton object on NSX
+const policyFirewallGlobalSettingsID = "security"
+
+func resourceNsxtPolicyFirewallGlobalSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallGlobalSettingsCreate,
+		Read:   resourceNsxtPolicyFirewallGlobalSettingsRead,
+		Update: resourceNsxtPolicyFirewallGlobalSettingsUpdate,
+		Delete: resourceNsxtPolicyFirewallGlobalSettingsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+			"enable_firewall": {
+				Type:        schema.TypeBool,
+				Description: "Enable Distributed Firewall",
+				Optional:    true,
+				Default:     true,
+			},
+			"disable_auto_drafts": {
+				Type:        schema.TypeBool,
+				Description: "Disable automatic creation of firewall configuration drafts",
+				Optional:    true,
+				Default:     false,
+			},
+			"global_addrset_mode_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable global address set mode in Distributed Firewall",
+				Optional:    true,
+				Default:     false,
+			},
+			"idfw_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable Identity Firewall",
+				Optional:    true,
+				Computed:    true,
+			},
+			"idfw_event_log_scraper_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable event log scraping for Identity Firewall",
+				Optional:    true,
+				Computed:    true,
+			},
+			"idfw_loginsight_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable collection of login/logout events from Log Insight server for Identity Firewall",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func policyFirewallGlobalSettingsPatch(m interface{}, obj model.DfwFirewallConfiguration) error {
+	connector := getPolicyConnector(m)
+	client := firewall.NewSecurityClient(connector)
+	obj.ResourceType = model.FirewallConfiguration_RESOURCE_TYPE_DFWFIREWALLCONFIGURATION
+
+	return client.Patch(obj)
+}
+
+func getPolicyFirewallGlobalSettingsFromSchema(d *schema.ResourceData) model.DfwFirewallConfiguration {
+	enableFirewall := d.Get("enable_firewall").(bool)
+	disableAutoDrafts := d.Get("disable_auto_drafts").(bool)
+	globalAddrsetModeEnabled := d.Get("global_addrset_mode_enabled").(bool)
+
+	obj := model.DfwFirewallConfiguration{
+		EnableFirewall:           &enableFirewall,
+		DisableAutoDrafts:        &disableAutoDrafts,
+		GlobalAddrsetModeEnabled: &globalAddrsetModeEnabled,
+	}
+
+	// Identity firewall flags are only modified when specified, since they might
+	// be managed together with the rest of identity firewall configuration
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.GetAttr("idfw_enabled").IsNull() {
+		idfwEnabled := d.Get("idfw_enabled").(bool)
+		obj.IdfwEnabled = &idfwEnabled
+	}
+	if !rawConfig.GetAttr("idfw_event_log_scraper_enabled").IsNull() {
+		idfwEventLogScraperEnabled := d.Get("idfw_event_log_scraper_enabled").(bool)
+		obj.IdfwEventLogScraperEnabled = &idfwEventLogScraperEnabled
+	}
+	if !rawConfig.GetAttr("idfw_loginsight_enabled").IsNull() {
+		idfwLoginsightEnabled := d.Get("idfw_loginsight_enabled").(bool)
+		obj.IdfwLoginsightEnabled = &idfwLoginsightEnabled
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicyFirewallGlobalSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Updating Firewall Global Settings")
+	err := policyFirewallGlobalSettingsPatch(m, getPolicyFirewallGlobalSettingsFromSchema(d))
+	if err != nil {
+		return handleCreateError("Firewall Global Settings", policyFirewallGlobalSettingsID, err)
+	}
+
+	d.SetId(policyFirewallGlobalSettingsID)
+
+	return resourceNsxtPolicyFirewallGlobalSettingsRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallGlobalSettingsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := firewall.NewSecurityClient(connector)
+
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Firewall Global Settings", policyFirewallGlobalSettingsID, err)
+	}
+
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("enable_firewall", obj.EnableFirewall)
+	d.Set("disable_auto_drafts", obj.DisableAutoDrafts)
+	d.Set("global_addrset_mode_enabled", obj.GlobalAddrsetModeEnabled)
+	d.Set("idfw_enabled", obj.IdfwEnabled)
+	d.Set("idfw_event_log_scraper_enabled", obj.IdfwEventLogScraperEnabled)
+	d.Set("idfw_loginsight_enabled", obj.IdfwLoginsightEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallGlobalSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Updating Firewall Global Settings")
+	err := policyFirewallGlobalSettingsPatch(m, getPolicyFirewallGlobalSettingsFromSchema(d))
+	if err != nil {
+		return handleUpdateError("Firewall Global Settings", policyFirewallGlobalSettingsID, err)
+	}
+
+	return resourceNsxtPolicyFirewallGlobalSettingsRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallGlobalSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	// Settings object can not be deleted, hence revert it to NSX defaults.
+	// Identity firewall flags are left intact.
+	enableFirewall := true
+	disabled := false
+	obj := model.DfwFirewallConfiguration{
+		EnableFirewall:           &enableFirewall,
+		DisableAutoDrafts:        &disabled,
+		GlobalAddrsetModeEnabled: &disabled,
+	}
+
+	log.Printf("[INFO] Reverting Firewall Global Settings to defaults")
+	err := policyFirewallGlobalSettingsPatch(m, obj)
+	if err != nil {
+		return handleDeleteError("Firewall Global Settings", policyFirewallGlobalSettingsID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall"
+)
+
+func TestAccResourceNsxtPolicyFirewallGlobalSettings_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_global_settings.test"
+
+	// Settings are global, hence this test can not run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallGlobalSettingsCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallGlobalSettingsTemplate(true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enable_firewall", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "idfw_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "global_addrset_mode_enabled", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				// Identity firewall is not modified when not specified
+				Config: testAccNsxtPolicyFirewallGlobalSettingsWithoutIdfwTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "idfw_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallGlobalSettingsTemplate(false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enable_firewall", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "disable_auto_drafts", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "idfw_enabled", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallGlobalSettingsCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := firewall.NewSecurityClient(connector)
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_firewall_global_settings" {
+			continue
+		}
+
+		obj, err := client.Get()
+		if err != nil {
+			return err
+		}
+
+		if obj.DisableAutoDrafts != nil && *obj.DisableAutoDrafts {
+			return fmt.Errorf("Firewall Global Settings were not reverted to defaults")
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallGlobalSettingsTemplate(disableAutoDrafts bool, idfwEnabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_global_settings" "test" {
+  enable_firewall     = true
+  disable_auto_drafts = %t
+  idfw_enabled        = %t
+}`, disableAutoDrafts, idfwEnabled)
+}
+
+func testAccNsxtPolicyFirewallGlobalSettingsWithoutIdfwTemplate(disableAutoDrafts bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_global_settings" "test" {
+  enable_firewall     = true
+  disable_auto_drafts = %t
+}`, disableAutoDrafts)
+}
@@ -6,6 +6,7 @@ package nsxt
 import (
 	"fmt"
 	"log"
+	"net"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -105,7 +106,7 @@ func setPrefixesInSchema(d *schema.ResourceData, prefixes []model.PrefixEntry) {
 	}
 }
 
-func getPrefixesFromSchema(d *schema.ResourceData) []model.PrefixEntry {
+func getPrefixesFromSchema(d *schema.ResourceData) ([]model.PrefixEntry, error) {
 	prefixes := d.Get("prefix").([]interface{})
 	var entriesList []model.PrefixEntry
 	for _, prefix := range prefixes {
@@ -131,10 +132,80 @@ func getPrefixesFromSchema(d *schema.ResourceData) []model.PrefixEntry {
 			elem.Le = &le
 		}
 
+		err := validatePrefixEntryLength(network, ge, le)
+		if err != nil {
+			return nil, err
+		}
+
 		entriesList = append(entriesList, elem)
 	}
 
-	return entriesList
+	return entriesList, nil
+}
+
+// Validate ge/le values against prefix length and maximum length of the network address family
+func validatePrefixEntryLength(network string, ge int64, le int64) error {
+	if ge > 0 && le > 0 && ge > le {
+		return fmt.Errorf("ge value %d is greater than le value %d for prefix %s", ge, le, network)
+	}
+	if network == "ANY" {
+		return nil
+	}
+
+	_, ipNet, err := net.ParseCIDR(network)
+	if err != nil {
+		return fmt.Errorf("Invalid network prefix %s: %v", network, err)
+	}
+	prefixLen, maxLen := ipNet.Mask.Size()
+	for _, length := range []int64{ge, le} {
+		if length == 0 {
+			continue
+		}
+		if length > int64(maxLen) {
+			return fmt.Errorf("Prefix length %d exceeds %d bits for prefix %s", length, maxLen, network)
+		}
+		if length < int64(prefixLen) {
+			return fmt.Errorf("Prefix length %d is shorter than mask length of prefix %s", length, network)
+		}
+	}
+
+	return nil
+}
+
+// Validate that all specific prefixes in the list belong to given BGP address family
+func validatePrefixEntriesAddressFamily(prefixListPath string, prefixes []model.PrefixEntry, addressFamily string) error {
+	for _, prefix := range prefixes {
+		if prefix.Network == nil || strings.ToUpper(*prefix.Network) == "ANY" {
+			continue
+		}
+		isV6 := isIPv6Address(*prefix.Network)
+		if addressFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_IPV4 && isV6 {
+			return fmt.Errorf("Prefix list %s contains IPv6 prefix %s and can not be used for %s address family", prefixListPath, *prefix.Network, addressFamily)
+		}
+		if addressFamily == model.BgpRouteFiltering_ADDRESS_FAMILY_IPV6 && !isV6 {
+			return fmt.Errorf("Prefix list %s contains IPv4 prefix %s and can not be used for %s address family", prefixListPath, *prefix.Network, addressFamily)
+		}
+	}
+
+	return nil
+}
+
+func policyGatewayPrefixListGet(connector client.Connector, gwID string, id string, isGlobalManager bool) (model.PrefixList, error) {
+	if isGlobalManager {
+		var obj model.PrefixList
+		client := gm_tier_0s.NewPrefixListsClient(connector)
+		gmObj, err := client.Get(gwID, id)
+		if err != nil {
+			return obj, err
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.PrefixListBindingType(), model.PrefixListBindingType())
+		if err != nil {
+			return obj, err
+		}
+		return rawObj.(model.PrefixList), nil
+	}
+	client := tier_0s.NewPrefixListsClient(connector)
+	return client.Get(gwID, id)
 }
 
 func resourceNsxtPolicyGatewayPrefixListDelete(d *schema.ResourceData, m interface{}) error {
@@ -182,27 +253,9 @@ func resourceNsxtPolicyGatewayPrefixListRead(d *schema.ResourceData, m interface
 		return fmt.Errorf("gateway_path is not valid")
 	}
 
-	var obj model.PrefixList
-	var err error
-	if isGlobalManager {
-		var gmObj gm_model.PrefixList
-		var rawObj interface{}
-		client := gm_tier_0s.NewPrefixListsClient(connector)
-		gmObj, err = client.Get(gwID, id)
-		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
-		}
-		rawObj, err = convertModelBindingType(gmObj, gm_model.PrefixListBindingType(), model.PrefixListBindingType())
-		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
-		}
-		obj = rawObj.(model.PrefixList)
-	} else {
-		client := tier_0s.NewPrefixListsClient(connector)
-		obj, err = client.Get(gwID, id)
-		if err != nil {
-			return handleReadError(d, "Gateway Prefix List", id, err)
-		}
+	obj, err := policyGatewayPrefixListGet(connector, gwID, id, isGlobalManager)
+	if err != nil {
+		return handleReadError(d, "Gateway Prefix List", id, err)
 	}
 
 	d.Set("display_name", obj.DisplayName)
@@ -262,7 +315,10 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	prefixes := getPrefixesFromSchema(d)
+	prefixes, err := getPrefixesFromSchema(d)
+	if err != nil {
+		return err
+	}
 	tags := getPolicyTagsFromSchema(d)
 
 	prefixListStruct := model.PrefixList{
@@ -275,7 +331,7 @@ func resourceNsxtPolicyGatewayPrefixListCreate(d *schema.ResourceData, m interfa
 
 	log.Printf("[INFO] Creating Gateway Prefix List with ID %s", id)
 
-	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isGlobalManager)
+	err = patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isGlobalManager)
 	if err != nil {
 		return handleCreateError("Gateway Prefix List", id, err)
 	}
@@ -302,7 +358,10 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
-	prefixes := getPrefixesFromSchema(d)
+	prefixes, err := getPrefixesFromSchema(d)
+	if err != nil {
+		return err
+	}
 	tags := getPolicyTagsFromSchema(d)
 
 	prefixListStruct := model.PrefixList{
@@ -314,7 +373,7 @@ func resourceNsxtPolicyGatewayPrefixListUpdate(d *schema.ResourceData, m interfa
 	}
 
 	log.Printf("[INFO] Updating Gateway Prefix List with ID %s", id)
-	err := patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isPolicyGlobalManager(m))
+	err = patchNsxtPolicyGatewayPrefixList(connector, gwID, prefixListStruct, isPolicyGlobalManager(m))
 	if err != nil {
 		return handleUpdateError("Gateway Prefix List", id, err)
 	}
@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -81,6 +82,28 @@ func TestAccResourceNsxtPolicyGatewayPrefixList_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyGatewayPrefixList_invalidLength(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyGWPrefixListCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyGWPrefixListCreateTemplate(name, model.PrefixEntry_ACTION_PERMIT, "24", "40", "4.4.0.0/20"),
+				ExpectError: regexp.MustCompile("exceeds 32 bits"),
+			},
+			{
+				Config:      testAccNsxtPolicyGWPrefixListCreateTemplate(name, model.PrefixEntry_ACTION_PERMIT, "16", "24", "4.4.0.0/20"),
+				ExpectError: regexp.MustCompile("shorter than mask length"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyGatewayPrefixList_import(t *testing.T) {
 	name := getAccTestResourceName()
 	action := model.PrefixEntry_ACTION_DENY
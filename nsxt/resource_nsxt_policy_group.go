@@ -93,42 +93,51 @@ func resourceNsxtPolicyGroup() *schema.Resource {
 			State: nsxtDomainResourceImporter,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"context":      getContextSchema(),
-			"domain":       getDomainNameSchema(),
-			"group_type": {
-				Type:         schema.TypeString,
-				Description:  "Indicates the group type",
-				ValidateFunc: validation.StringInSlice(groupTypeValues, false),
-				Optional:     true,
-			},
-			"criteria": {
-				Type:        schema.TypeList,
-				Description: "Criteria to determine Group membership",
-				Elem:        getCriteriaSetSchema(),
-				Optional:    true,
-			},
-			"conjunction": {
-				Type:        schema.TypeList,
-				Description: "A conjunction applied to 2 sets of criteria.",
-				Elem:        getConjunctionSchema(),
-				Optional:    true,
-			},
-			"extended_criteria": {
-				Type:        schema.TypeList,
-				Description: "Extended criteria to determine group membership. extended_criteria is implicitly \"AND\" with criteria",
-				Elem:        getExtendedCriteriaSetSchema(),
-				Optional:    true,
-				MaxItems:    1,
-			},
+		Schema: getPolicyGroupSchema(true),
+	}
+}
+
+func getPolicyGroupSchema(withDomain bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"nsx_id":       getNsxIDSchema(),
+		"path":         getPathSchema(),
+		"display_name": getDisplayNameSchema(),
+		"description":  getDescriptionSchema(),
+		"revision":     getRevisionSchema(),
+		"tag":          getTagsSchema(),
+		"context":      getContextSchema(),
+		"domain":       getDomainNameSchema(),
+		"group_type": {
+			Type:         schema.TypeString,
+			Description:  "Indicates the group type",
+			ValidateFunc: validation.StringInSlice(groupTypeValues, false),
+			Optional:     true,
+		},
+		"criteria": {
+			Type:        schema.TypeList,
+			Description: "Criteria to determine Group membership",
+			Elem:        getCriteriaSetSchema(),
+			Optional:    true,
+		},
+		"conjunction": {
+			Type:        schema.TypeList,
+			Description: "A conjunction applied to 2 sets of criteria.",
+			Elem:        getConjunctionSchema(),
+			Optional:    true,
+		},
+		"extended_criteria": {
+			Type:        schema.TypeList,
+			Description: "Extended criteria to determine group membership. extended_criteria is implicitly \"AND\" with criteria",
+			Elem:        getExtendedCriteriaSetSchema(),
+			Optional:    true,
+			MaxItems:    1,
 		},
 	}
+
+	if !withDomain {
+		delete(s, "domain")
+	}
+	return s
 }
 
 func getIPAddressExpressionSchema() *schema.Resource {
@@ -828,11 +837,35 @@ func validateGroupCriteriaAndConjunctions(criteriaSets []interface{}, conjunctio
 	return criteriaMeta, nil
 }
 
+func getPolicyGroupDomain(d *schema.ResourceData, withDomain bool) string {
+	if withDomain {
+		return d.Get("domain").(string)
+	}
+	return ""
+}
+
 func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralCreate(d, m, true)
+}
+
+func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralRead(d, m, true)
+}
+
+func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralUpdate(d, m, true)
+}
+
+func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralDelete(d, m, true)
+}
+
+func resourceNsxtPolicyGroupGeneralCreate(d *schema.ResourceData, m interface{}, withDomain bool) error {
 	connector := getPolicyConnector(m)
+	domain := getPolicyGroupDomain(d, withDomain)
 
 	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGroupExistsInDomainPartial(d.Get("domain").(string)))
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyGroupExistsInDomainPartial(domain))
 	if err != nil {
 		return err
 	}
@@ -879,7 +912,7 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	client := domains.NewGroupsClient(getSessionContext(d, m), connector)
-	err = client.Patch(d.Get("domain").(string), id, obj)
+	err = client.Patch(domain, id, obj)
 
 	// Create the resource using PATCH
 	log.Printf("[INFO] Creating Group with ID %s", id)
@@ -890,13 +923,13 @@ func resourceNsxtPolicyGroupCreate(d *schema.ResourceData, m interface{}) error
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupGeneralRead(d, m, withDomain)
 }
 
-func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupGeneralRead(d *schema.ResourceData, m interface{}, withDomain bool) error {
 	connector := getPolicyConnector(m)
 	id := d.Id()
-	domainName := d.Get("domain").(string)
+	domainName := getPolicyGroupDomain(d, withDomain)
 	if id == "" {
 		return fmt.Errorf("Error obtaining Group ID")
 	}
@@ -910,7 +943,9 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	setPolicyTagsInSchema(d, obj.Tags)
 	d.Set("nsx_id", id)
 	d.Set("path", obj.Path)
-	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	if withDomain {
+		d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	}
 	d.Set("revision", obj.Revision)
 	groupType := ""
 	if len(obj.GroupType) > 0 && nsxVersionHigherOrEqual("3.2.0") {
@@ -940,7 +975,7 @@ func resourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupGeneralUpdate(d *schema.ResourceData, m interface{}, withDomain bool) error {
 	connector := getPolicyConnector(m)
 
 	id := d.Id()
@@ -994,15 +1029,15 @@ func resourceNsxtPolicyGroupUpdate(d *schema.ResourceData, m interface{}) error
 	client := domains.NewGroupsClient(getSessionContext(d, m), connector)
 
 	// Update the resource using PATCH
-	err = client.Patch(d.Get("domain").(string), id, obj)
+	err = client.Patch(getPolicyGroupDomain(d, withDomain), id, obj)
 	if err != nil {
 		return handleUpdateError("Group", id, err)
 	}
 
-	return resourceNsxtPolicyGroupRead(d, m)
+	return resourceNsxtPolicyGroupGeneralRead(d, m, withDomain)
 }
 
-func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error {
+func resourceNsxtPolicyGroupGeneralDelete(d *schema.ResourceData, m interface{}, withDomain bool) error {
 	id := d.Id()
 	if id == "" {
 		return fmt.Errorf("Error obtaining Group ID")
@@ -1014,7 +1049,7 @@ func resourceNsxtPolicyGroupDelete(d *schema.ResourceData, m interface{}) error
 
 	doDelete := func() error {
 		client := domains.NewGroupsClient(getSessionContext(d, m), connector)
-		return client.Delete(d.Get("domain").(string), id, &failIfSubtreeExists, &forceDelete)
+		return client.Delete(getPolicyGroupDomain(d, withDomain), id, &failIfSubtreeExists, &forceDelete)
 	}
 
 	err := doDelete()
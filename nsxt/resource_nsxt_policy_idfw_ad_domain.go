@@ -0,0 +1,365 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var idfwLdapServerProtocolValues = []string{
+	model.DirectoryLdapServer_PROTOCOL_LDAP,
+	model.DirectoryLdapServer_PROTOCOL_LDAPS,
+}
+
+func resourceNsxtPolicyIdfwAdDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIdfwAdDomainCreate,
+		Read:   resourceNsxtPolicyIdfwAdDomainRead,
+		Update: resourceNsxtPolicyIdfwAdDomainUpdate,
+		Delete: resourceNsxtPolicyIdfwAdDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"domain_name": {
+				Type:        schema.TypeString,
+				Description: "Fully qualified domain name of Active Directory domain",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"netbios_name": {
+				Type:        schema.TypeString,
+				Description: "NetBIOS name of Active Directory domain",
+				Required:    true,
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Base distinguished name for the domain, for example DC=example,DC=com",
+				Required:    true,
+			},
+			"sync_settings": {
+				Type:        schema.TypeList,
+				Description: "Synchronization schedule for the domain",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delta_sync_interval": {
+							Type:         schema.TypeInt,
+							Description:  "Interval in minutes between two delta synchronizations",
+							Optional:     true,
+							Default:      180,
+							ValidateFunc: validation.IntBetween(5, 720),
+						},
+						"full_sync_cron_expression": {
+							Type:        schema.TypeString,
+							Description: "Full synchronization schedule as cron expression",
+							Optional:    true,
+						},
+						"sync_delay": {
+							Type:        schema.TypeInt,
+							Description: "Delay in seconds before initial full synchronization after domain creation. Value of -1 means no initial synchronization",
+							Optional:    true,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ldap_server": {
+				Type:        schema.TypeList,
+				Description: "LDAP servers used for synchronization of users and groups",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Description: "LDAP server host name or IP address",
+							Required:    true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "LDAP server port",
+							Optional:     true,
+							Default:      389,
+							ValidateFunc: validateSinglePort(),
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Description:  "LDAP server connection protocol",
+							Optional:     true,
+							Default:      model.DirectoryLdapServer_PROTOCOL_LDAP,
+							ValidateFunc: validation.StringInSlice(idfwLdapServerProtocolValues, false),
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "Username for LDAP server connection",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password for LDAP server connection",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"thumbprint": {
+							Type:        schema.TypeString,
+							Description: "LDAP server certificate thumbprint, relevant for LDAPS protocol",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getIdfwLdapServersFromSchema(d *schema.ResourceData) []model.DirectoryLdapServer {
+	domainName := d.Get("domain_name").(string)
+	servers := d.Get("ldap_server").([]interface{})
+	var serverList []model.DirectoryLdapServer
+	for _, server := range servers {
+		data := server.(map[string]interface{})
+		host := data["host"].(string)
+		port := int64(data["port"].(int))
+		protocol := data["protocol"].(string)
+		username := data["username"].(string)
+		password := data["password"].(string)
+		elem := model.DirectoryLdapServer{
+			DomainName: &domainName,
+			Host:       &host,
+			Port:       &port,
+			Protocol:   &protocol,
+			Username:   &username,
+			Password:   &password,
+		}
+		thumbprint := data["thumbprint"].(string)
+		if len(thumbprint) > 0 {
+			elem.Thumbprint = &thumbprint
+		}
+
+		serverList = append(serverList, elem)
+	}
+
+	return serverList
+}
+
+// getIdfwLdapServerPasswordMap caches password of ldap servers for setting back to schema after read
+func getIdfwLdapServerPasswordMap(d *schema.ResourceData) map[string]string {
+	passwordMap := make(map[string]string)
+	servers := d.Get("ldap_server").([]interface{})
+	for _, server := range servers {
+		data := server.(map[string]interface{})
+		passwordMap[data["host"].(string)] = data["password"].(string)
+	}
+
+	return passwordMap
+}
+
+func setIdfwLdapServersInSchema(d *schema.ResourceData, servers []model.DirectoryLdapServer, passwordMap map[string]string) {
+	var serverList []map[string]interface{}
+	for _, server := range servers {
+		elem := make(map[string]interface{})
+		elem["host"] = server.Host
+		elem["port"] = server.Port
+		elem["protocol"] = server.Protocol
+		elem["username"] = server.Username
+		elem["thumbprint"] = server.Thumbprint
+		if server.Host != nil {
+			if val, ok := passwordMap[*server.Host]; ok {
+				elem["password"] = val
+			}
+		}
+		serverList = append(serverList, elem)
+	}
+
+	err := d.Set("ldap_server", serverList)
+	if err != nil {
+		log.Printf("[WARNING] Failed to set ldap_server in schema: %v", err)
+	}
+}
+
+func getIdfwSyncSettingsFromSchema(d *schema.ResourceData) *model.DirectoryDomainSyncSettings {
+	settings := d.Get("sync_settings").([]interface{})
+	if len(settings) == 0 || settings[0] == nil {
+		return nil
+	}
+
+	data := settings[0].(map[string]interface{})
+	deltaSyncInterval := int64(data["delta_sync_interval"].(int))
+	syncDelay := int64(data["sync_delay"].(int))
+	result := model.DirectoryDomainSyncSettings{
+		DeltaSyncInterval: &deltaSyncInterval,
+		SyncDelayInSec:    &syncDelay,
+	}
+	cronExpr := data["full_sync_cron_expression"].(string)
+	if len(cronExpr) > 0 {
+		result.FullSyncCronExpr = &cronExpr
+	}
+
+	return &result
+}
+
+func setIdfwSyncSettingsInSchema(d *schema.ResourceData, settings *model.DirectoryDomainSyncSettings) {
+	var settingsList []map[string]interface{}
+	if settings != nil {
+		elem := make(map[string]interface{})
+		elem["delta_sync_interval"] = settings.DeltaSyncInterval
+		elem["full_sync_cron_expression"] = settings.FullSyncCronExpr
+		elem["sync_delay"] = settings.SyncDelayInSec
+		settingsList = append(settingsList, elem)
+	}
+
+	err := d.Set("sync_settings", settingsList)
+	if err != nil {
+		log.Printf("[WARNING] Failed to set sync_settings in schema: %v", err)
+	}
+}
+
+func resourceNsxtPolicyIdfwAdDomainExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyIdfwAdDomainPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	domainName := d.Get("domain_name").(string)
+	netbiosName := d.Get("netbios_name").(string)
+	baseDn := d.Get("base_distinguished_name").(string)
+
+	obj := model.DirectoryAdDomain{
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		Name:                  &domainName,
+		NetbiosName:           &netbiosName,
+		BaseDistinguishedName: &baseDn,
+		SyncSettings:          getIdfwSyncSettingsFromSchema(d),
+		LdapServers:           getIdfwLdapServersFromSchema(d),
+		ResourceType:          model.DirectoryDomain_RESOURCE_TYPE_DIRECTORYADDOMAIN,
+	}
+
+	dataValue, errs := converter.ConvertToVapi(obj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	log.Printf("[INFO] Patching IDFW AD Domain with ID %s", id)
+	return client.Patch(id, dataValue.(*data.StructValue), nil)
+}
+
+func resourceNsxtPolicyIdfwAdDomainCreate(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIdfwAdDomainExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIdfwAdDomainPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IDFW AD Domain", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIdfwAdDomainRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwAdDomainRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW AD Domain ID")
+	}
+
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	structObj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "IDFW AD Domain", id, err)
+	}
+
+	obj, errs := converter.ConvertToGolang(structObj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+	domain := obj.(model.DirectoryAdDomain)
+
+	passwordMap := getIdfwLdapServerPasswordMap(d)
+
+	d.Set("nsx_id", id)
+	d.Set("display_name", domain.DisplayName)
+	d.Set("description", domain.Description)
+	d.Set("revision", domain.Revision)
+	setPolicyTagsInSchema(d, domain.Tags)
+	d.Set("domain_name", domain.Name)
+	d.Set("netbios_name", domain.NetbiosName)
+	d.Set("base_distinguished_name", domain.BaseDistinguishedName)
+	setIdfwSyncSettingsInSchema(d, domain.SyncSettings)
+	setIdfwLdapServersInSchema(d, domain.LdapServers, passwordMap)
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwAdDomainUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW AD Domain ID")
+	}
+
+	err := resourceNsxtPolicyIdfwAdDomainPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IDFW AD Domain", id, err)
+	}
+
+	return resourceNsxtPolicyIdfwAdDomainRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwAdDomainDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW AD Domain ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("IDFW AD Domain", id, err)
+	}
+
+	return nil
+}
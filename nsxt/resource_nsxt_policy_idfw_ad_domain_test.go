@@ -0,0 +1,168 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyIdfwPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccNSXVersion(t, "3.1.0")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_USER")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_PASSWORD")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_HOST")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_DOMAIN")
+	testAccEnvDefined(t, "NSXT_TEST_LDAP_BASE_DN")
+	testAccEnvDefined(t, "NSXT_TEST_AD_NETBIOS_NAME")
+}
+
+func TestAccResourceNsxtPolicyIdfwAdDomain_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_idfw_ad_domain.test"
+
+	// Only one AD domain with given name can exist on NSX
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtPolicyIdfwPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdfwAdDomainCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwAdDomainTemplate(name, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdfwAdDomainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain_name", getTestLdapDomain()),
+					resource.TestCheckResourceAttr(testResourceName, "netbios_name", getTestAdNetbiosName()),
+					resource.TestCheckResourceAttr(testResourceName, "base_distinguished_name", getTestLdapBaseDN()),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "180"),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.0.host", getTestLdapHost()),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.0.username", getTestLdapUser()),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdfwAdDomainTemplate(updatedName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdfwAdDomainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "sync_settings.0.delta_sync_interval", "60"),
+					resource.TestCheckResourceAttr(testResourceName, "ldap_server.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIdfwAdDomain_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_idfw_ad_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtPolicyIdfwPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdfwAdDomainCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwAdDomainTemplate(name, 180),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ldap_server.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdfwAdDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IDFW AD Domain resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IDFW AD Domain resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIdfwAdDomainExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IDFW AD Domain %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIdfwAdDomainCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_idfw_ad_domain" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIdfwAdDomainExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IDFW AD Domain %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIdfwAdDomainTemplate(name string, deltaSyncInterval int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_idfw_ad_domain" "test" {
+  display_name            = "%s"
+  domain_name             = "%s"
+  netbios_name            = "%s"
+  base_distinguished_name = "%s"
+
+  sync_settings {
+    delta_sync_interval       = %d
+    full_sync_cron_expression = "0 0 12 ? * SUN *"
+  }
+
+  ldap_server {
+    host     = "%s"
+    username = "%s"
+    password = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestLdapDomain(), getTestAdNetbiosName(), getTestLdapBaseDN(), deltaSyncInterval,
+		getTestLdapHost(), getTestLdapUser(), getTestLdapPassword())
+}
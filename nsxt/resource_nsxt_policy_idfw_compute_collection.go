@@ -0,0 +1,125 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/idfw"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIdfwComputeCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIdfwComputeCollectionCreate,
+		Read:   resourceNsxtPolicyIdfwComputeCollectionRead,
+		Update: resourceNsxtPolicyIdfwComputeCollectionUpdate,
+		Delete: resourceNsxtPolicyIdfwComputeCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+			"compute_collection_id": {
+				Type:        schema.TypeString,
+				Description: "Compute collection (cluster) ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable Identity Firewall on this compute collection",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIdfwComputeCollectionPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	client := idfw.NewClusterClient(connector)
+
+	enabled := d.Get("enabled").(bool)
+	obj := model.ComputeClusterIdfwConfiguration{
+		ClusterIdfwEnabled: &enabled,
+		Member: &model.PolicyResourceReference{
+			TargetId: &id,
+		},
+	}
+
+	log.Printf("[INFO] Patching IDFW configuration for compute collection %s", id)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyIdfwComputeCollectionCreate(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("compute_collection_id").(string)
+
+	err := resourceNsxtPolicyIdfwComputeCollectionPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IDFW Compute Collection", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyIdfwComputeCollectionRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwComputeCollectionRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Compute Collection ID")
+	}
+
+	client := idfw.NewClusterClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IDFW Compute Collection", id, err)
+	}
+
+	d.Set("compute_collection_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("enabled", obj.ClusterIdfwEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwComputeCollectionUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Compute Collection ID")
+	}
+
+	err := resourceNsxtPolicyIdfwComputeCollectionPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IDFW Compute Collection", id, err)
+	}
+
+	return resourceNsxtPolicyIdfwComputeCollectionRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwComputeCollectionDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Compute Collection ID")
+	}
+
+	// Deleting the configuration reverts the compute collection to NSX default,
+	// which is IDFW disabled
+	connector := getPolicyConnector(m)
+	client := idfw.NewClusterClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("IDFW Compute Collection", id, err)
+	}
+
+	return nil
+}
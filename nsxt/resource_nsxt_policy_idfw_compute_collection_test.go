@@ -0,0 +1,80 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyIdfwComputeCollection_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_idfw_compute_collection.test"
+
+	// Configuration is per compute collection, hence this test can not run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwComputeCollectionTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "compute_collection_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdfwComputeCollectionTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "compute_collection_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIdfwComputeCollection_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_idfw_compute_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_COLLECTION")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwComputeCollectionTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdfwComputeCollectionTemplate(enabled bool) string {
+	return fmt.Sprintf(`
+data "nsxt_compute_collection" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_idfw_compute_collection" "test" {
+  compute_collection_id = data.nsxt_compute_collection.test.id
+  enabled               = %t
+}`, getComputeCollectionName(), enabled)
+}
@@ -0,0 +1,202 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIdfwEventLogServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIdfwEventLogServerCreate,
+		Read:   resourceNsxtPolicyIdfwEventLogServerRead,
+		Update: resourceNsxtPolicyIdfwEventLogServerUpdate,
+		Delete: resourceNsxtPolicyIdfwEventLogServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyIdfwEventLogServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ad_domain_id": {
+				Type:        schema.TypeString,
+				Description: "ID of IDFW AD Domain this server belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Event log server host name or IP address",
+				Required:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "Username for event log server connection",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password for event log server connection",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Connection status of event log server",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIdfwEventLogServerExistsInDomain(id string, domainID string, connector client.Connector) (bool, error) {
+	client := firewall_identity_stores.NewEventLogServersClient(connector)
+	_, err := client.Get(domainID, id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyIdfwEventLogServerPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	client := firewall_identity_stores.NewEventLogServersClient(connector)
+
+	domainID := d.Get("ad_domain_id").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	host := d.Get("host").(string)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+
+	obj := model.DirectoryEventLogServer{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Host:        &host,
+		Username:    &username,
+		Password:    &password,
+	}
+
+	log.Printf("[INFO] Patching IDFW Event Log Server with ID %s in AD Domain %s", id, domainID)
+	return client.Patch(domainID, id, obj, nil)
+}
+
+func resourceNsxtPolicyIdfwEventLogServerCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	domainID := d.Get("ad_domain_id").(string)
+
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	} else {
+		exists, err := resourceNsxtPolicyIdfwEventLogServerExistsInDomain(id, domainID, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Resource with id %s already exists", id)
+		}
+	}
+
+	err := resourceNsxtPolicyIdfwEventLogServerPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IDFW Event Log Server", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIdfwEventLogServerRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwEventLogServerRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Event Log Server ID")
+	}
+	domainID := d.Get("ad_domain_id").(string)
+
+	client := firewall_identity_stores.NewEventLogServersClient(connector)
+	obj, err := client.Get(domainID, id, nil)
+	if err != nil {
+		return handleReadError(d, "IDFW Event Log Server", id, err)
+	}
+
+	d.Set("nsx_id", id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("revision", obj.Revision)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("host", obj.Host)
+	d.Set("username", obj.Username)
+	if obj.Status != nil {
+		d.Set("status", obj.Status.Status)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwEventLogServerUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Event Log Server ID")
+	}
+
+	err := resourceNsxtPolicyIdfwEventLogServerPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IDFW Event Log Server", id, err)
+	}
+
+	return resourceNsxtPolicyIdfwEventLogServerRead(d, m)
+}
+
+func resourceNsxtPolicyIdfwEventLogServerDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IDFW Event Log Server ID")
+	}
+	domainID := d.Get("ad_domain_id").(string)
+
+	connector := getPolicyConnector(m)
+	client := firewall_identity_stores.NewEventLogServersClient(connector)
+	err := client.Delete(domainID, id, nil)
+	if err != nil {
+		return handleDeleteError("IDFW Event Log Server", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIdfwEventLogServerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <ad-domain-id>/<event-log-server-id> as an input")
+	}
+
+	d.SetId(s[1])
+	d.Set("ad_domain_id", s[0])
+
+	return []*schema.ResourceData{d}, nil
+}
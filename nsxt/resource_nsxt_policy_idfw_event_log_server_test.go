@@ -0,0 +1,146 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyIdfwEventLogServer_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_idfw_event_log_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtPolicyIdfwPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdfwEventLogServerCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwEventLogServerTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdfwEventLogServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "host", getTestLdapHost()),
+					resource.TestCheckResourceAttr(testResourceName, "username", getTestLdapUser()),
+					resource.TestCheckResourceAttrSet(testResourceName, "ad_domain_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIdfwEventLogServerTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIdfwEventLogServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "host", getTestLdapHost()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIdfwEventLogServer_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_idfw_event_log_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccNsxtPolicyIdfwPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIdfwEventLogServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIdfwEventLogServerTemplate(name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "status"},
+				ImportStateIdFunc:       testAccNsxtPolicyIdfwEventLogServerImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIdfwEventLogServerImportIDRetriever(resourceID string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceID]
+		if !ok {
+			return "", fmt.Errorf("IDFW Event Log Server resource %s not found in resources", resourceID)
+		}
+		domainID := rs.Primary.Attributes["ad_domain_id"]
+		if rs.Primary.ID == "" || domainID == "" {
+			return "", fmt.Errorf("IDFW Event Log Server resource ID or AD domain ID not set in resources")
+		}
+		return fmt.Sprintf("%s/%s", domainID, rs.Primary.ID), nil
+	}
+}
+
+func testAccNsxtPolicyIdfwEventLogServerExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IDFW Event Log Server resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IDFW Event Log Server resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIdfwEventLogServerExistsInDomain(resourceID, rs.Primary.Attributes["ad_domain_id"], connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IDFW Event Log Server %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIdfwEventLogServerCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_idfw_event_log_server" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIdfwEventLogServerExistsInDomain(resourceID, rs.Primary.Attributes["ad_domain_id"], connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IDFW Event Log Server %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIdfwEventLogServerTemplate(name string) string {
+	return testAccNsxtPolicyIdfwAdDomainTemplate(name, 180) + fmt.Sprintf(`
+resource "nsxt_policy_idfw_event_log_server" "test" {
+  display_name = "%s"
+  ad_domain_id = nsxt_policy_idfw_ad_domain.test.id
+  host         = "%s"
+  username     = "%s"
+  password     = "%s"
+}`, name, getTestLdapHost(), getTestLdapUser(), getTestLdapPassword())
+}
@@ -210,30 +210,8 @@ func createChildDomainWithIdsSecurityPolicy(domain string, policyID string, poli
 	return dataValue.(*data.StructValue), nil
 }
 
-func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) error {
-
-	domain := d.Get("domain").(string)
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
-	comments := d.Get("comments").(string)
-	locked := d.Get("locked").(bool)
-	sequenceNumber := int64(d.Get("sequence_number").(int))
-	stateful := d.Get("stateful").(bool)
-	resourceType := "IdsSecurityPolicy"
-
-	obj := model.IdsSecurityPolicy{
-		Id:             &id,
-		DisplayName:    &displayName,
-		Description:    &description,
-		Tags:           tags,
-		Comments:       &comments,
-		Locked:         &locked,
-		SequenceNumber: &sequenceNumber,
-		Stateful:       &stateful,
-		ResourceType:   &resourceType,
-	}
-
+// Rules removed from configuration are marked for delete. Shared with Malware Prevention policies.
+func getPolicyIdsChildRules(d *schema.ResourceData) ([]*data.StructValue, error) {
 	var childRules []*data.StructValue
 	if d.HasChange("rule") {
 		oldRules, _ := d.GetChange("rule")
@@ -251,7 +229,7 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 
 			childRule, err := createPolicyChildIdsRule(ruleID, rule, false)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			log.Printf("[DEBUG]: Adding child rule with id %s", ruleID)
 			childRules = append(childRules, childRule)
@@ -270,7 +248,7 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 
 				childRule, err := createPolicyChildIdsRule(oldRuleID, rule, true)
 				if err != nil {
-					return err
+					return nil, err
 				}
 				log.Printf("[DEBUG]: Deleting child rule with id %s", oldRuleID)
 				childRules = append(childRules, childRule)
@@ -279,6 +257,38 @@ func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) e
 		}
 	}
 
+	return childRules, nil
+}
+
+func updateIdsSecurityPolicy(id string, d *schema.ResourceData, m interface{}) error {
+
+	domain := d.Get("domain").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	stateful := d.Get("stateful").(bool)
+	resourceType := "IdsSecurityPolicy"
+
+	obj := model.IdsSecurityPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		Stateful:       &stateful,
+		ResourceType:   &resourceType,
+	}
+
+	childRules, err := getPolicyIdsChildRules(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG]: Updating IDS policy %s with %d child rules", id, len(childRules))
 	if len(childRules) > 0 {
 		obj.Children = childRules
@@ -0,0 +1,355 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lBCookiePersistenceProfileCookieModeValues = []string{
+	model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_PREFIX,
+	model.LBCookiePersistenceProfile_COOKIE_MODE_REWRITE,
+}
+
+var lBCookiePersistenceProfileCookieExpiryTypeValues = []string{
+	"SESSION_COOKIE_TIME",
+	"PERSISTENCE_COOKIE_TIME",
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBCookiePersistenceProfileCreate,
+		Read:   resourceNsxtPolicyLBCookiePersistenceProfileRead,
+		Update: resourceNsxtPolicyLBCookiePersistenceProfileUpdate,
+		Delete: resourceNsxtPolicyLBCookiePersistenceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"cookie_mode": {
+				Type:         schema.TypeString,
+				Description:  "Cookie persistence mode",
+				Optional:     true,
+				Default:      model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+				ValidateFunc: validation.StringInSlice(lBCookiePersistenceProfileCookieModeValues, false),
+			},
+			"cookie_name": {
+				Type:        schema.TypeString,
+				Description: "Cookie name",
+				Required:    true,
+			},
+			"cookie_fallback": {
+				Type:        schema.TypeBool,
+				Description: "If true, once the server pointed by the cookie is down, a new server is selected. If false, the request will be rejected",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_garble": {
+				Type:        schema.TypeBool,
+				Description: "If true, cookie value (server IP and port) would be encrypted",
+				Optional:    true,
+				Default:     true,
+			},
+			"persistence_shared": getLbPersistenceSharedSchema(),
+			"insert_mode_params": {
+				Type:        schema.TypeList,
+				Description: "Additional parameters for the INSERT cookie mode",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookie_domain": {
+							Type:        schema.TypeString,
+							Description: "HTTP cookie domain",
+							Optional:    true,
+						},
+						"cookie_path": {
+							Type:        schema.TypeString,
+							Description: "HTTP cookie path",
+							Optional:    true,
+						},
+						"cookie_httponly": {
+							Type:        schema.TypeBool,
+							Description: "If true, prevents a script running in the browser from accessing the cookie",
+							Optional:    true,
+							Default:     false,
+						},
+						"cookie_secure": {
+							Type:        schema.TypeBool,
+							Description: "If true, prevents the browser from sending the cookie over http",
+							Optional:    true,
+							Default:     false,
+						},
+						"cookie_expiry_type": {
+							Type:         schema.TypeString,
+							Description:  "Type of cookie expiration timing",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(lBCookiePersistenceProfileCookieExpiryTypeValues, false),
+						},
+						"max_idle_time": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the last time it was seen in a request",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_life_time": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the first time it was seen in a request. Only relevant for SESSION_COOKIE_TIME expiry type",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBPersistenceProfileExists(id, connector, isGlobalManager)
+}
+
+func getPolicyLBCookieInsertParamsFromSchema(d *schema.ResourceData, obj *model.LBCookiePersistenceProfile) error {
+	cookieMode := d.Get("cookie_mode").(string)
+	params := d.Get("insert_mode_params").([]interface{})
+	if len(params) == 0 || params[0] == nil {
+		return nil
+	}
+	if cookieMode != model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT {
+		return fmt.Errorf("insert_mode_params are only applicable for %s cookie mode", model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT)
+	}
+
+	paramData := params[0].(map[string]interface{})
+	cookieDomain := paramData["cookie_domain"].(string)
+	cookiePath := paramData["cookie_path"].(string)
+	cookieHttponly := paramData["cookie_httponly"].(bool)
+	cookieSecure := paramData["cookie_secure"].(bool)
+	expiryType := paramData["cookie_expiry_type"].(string)
+	maxIdle := int64(paramData["max_idle_time"].(int))
+	maxLife := int64(paramData["max_life_time"].(int))
+
+	if len(cookieDomain) > 0 {
+		obj.CookieDomain = &cookieDomain
+	}
+	if len(cookiePath) > 0 {
+		obj.CookiePath = &cookiePath
+	}
+	obj.CookieHttponly = &cookieHttponly
+	obj.CookieSecure = &cookieSecure
+
+	converter := bindings.NewTypeConverter()
+	switch expiryType {
+	case "SESSION_COOKIE_TIME":
+		cookieTime := model.LBSessionCookieTime{
+			Type_: model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME,
+		}
+		if maxIdle > 0 {
+			cookieTime.CookieMaxIdle = &maxIdle
+		}
+		if maxLife > 0 {
+			cookieTime.CookieMaxLife = &maxLife
+		}
+		dataValue, errs := converter.ConvertToVapi(cookieTime, model.LBSessionCookieTimeBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obj.CookieTime = dataValue.(*data.StructValue)
+	case "PERSISTENCE_COOKIE_TIME":
+		if maxIdle == 0 {
+			return fmt.Errorf("max_idle_time is required for %s expiry type", expiryType)
+		}
+		if maxLife > 0 {
+			return fmt.Errorf("max_life_time is not applicable for %s expiry type", expiryType)
+		}
+		cookieTime := model.LBPersistenceCookieTime{
+			Type_:         model.LBCookieTime_TYPE_LBPERSISTENCECOOKIETIME,
+			CookieMaxIdle: &maxIdle,
+		}
+		dataValue, errs := converter.ConvertToVapi(cookieTime, model.LBPersistenceCookieTimeBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		obj.CookieTime = dataValue.(*data.StructValue)
+	default:
+		if maxIdle > 0 || maxLife > 0 {
+			return fmt.Errorf("cookie_expiry_type is required when cookie time is specified")
+		}
+	}
+
+	return nil
+}
+
+func setPolicyLBCookieInsertParamsInSchema(d *schema.ResourceData, obj model.LBCookiePersistenceProfile) error {
+	if obj.CookieMode == nil || *obj.CookieMode != model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT {
+		return d.Set("insert_mode_params", nil)
+	}
+	if len(d.Get("insert_mode_params").([]interface{})) == 0 && obj.CookieDomain == nil && obj.CookiePath == nil && obj.CookieTime == nil {
+		// Avoid non-empty diff when insert mode params are not configured
+		return nil
+	}
+
+	elem := make(map[string]interface{})
+	elem["cookie_domain"] = obj.CookieDomain
+	elem["cookie_path"] = obj.CookiePath
+	elem["cookie_httponly"] = obj.CookieHttponly
+	elem["cookie_secure"] = obj.CookieSecure
+	if obj.CookieTime != nil {
+		converter := bindings.NewTypeConverter()
+		baseObj, errs := converter.ConvertToGolang(obj.CookieTime, model.LBCookieTimeBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		if baseObj.(model.LBCookieTime).Type_ == model.LBCookieTime_TYPE_LBSESSIONCOOKIETIME {
+			timeObj, errs := converter.ConvertToGolang(obj.CookieTime, model.LBSessionCookieTimeBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			cookieTime := timeObj.(model.LBSessionCookieTime)
+			elem["cookie_expiry_type"] = "SESSION_COOKIE_TIME"
+			elem["max_idle_time"] = cookieTime.CookieMaxIdle
+			elem["max_life_time"] = cookieTime.CookieMaxLife
+		} else {
+			timeObj, errs := converter.ConvertToGolang(obj.CookieTime, model.LBPersistenceCookieTimeBindingType())
+			if len(errs) > 0 {
+				return errs[0]
+			}
+			cookieTime := timeObj.(model.LBPersistenceCookieTime)
+			elem["cookie_expiry_type"] = "PERSISTENCE_COOKIE_TIME"
+			elem["max_idle_time"] = cookieTime.CookieMaxIdle
+		}
+	}
+
+	return d.Set("insert_mode_params", []interface{}{elem})
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cookieMode := d.Get("cookie_mode").(string)
+	cookieName := d.Get("cookie_name").(string)
+	cookieFallback := d.Get("cookie_fallback").(bool)
+	cookieGarble := d.Get("cookie_garble").(bool)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	obj := model.LBCookiePersistenceProfile{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		CookieMode:        &cookieMode,
+		CookieName:        &cookieName,
+		CookieFallback:    &cookieFallback,
+		CookieGarble:      &cookieGarble,
+		PersistenceShared: &persistenceShared,
+		ResourceType:      model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE,
+	}
+
+	err := getPolicyLBCookieInsertParamsFromSchema(d, &obj)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Patching LBCookiePersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBCookiePersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBCookiePersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBCookiePersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBCookiePersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBCookiePersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBCookiePersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBCookiePersistenceProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("cookie_mode", profile.CookieMode)
+	d.Set("cookie_name", profile.CookieName)
+	d.Set("cookie_fallback", profile.CookieFallback)
+	d.Set("cookie_garble", profile.CookieGarble)
+	d.Set("persistence_shared", profile.PersistenceShared)
+
+	return setPolicyLBCookieInsertParamsInSchema(d, profile)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyLBPersistenceProfileDelete(d, m)
+}
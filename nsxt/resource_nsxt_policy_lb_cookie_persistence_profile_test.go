@@ -0,0 +1,222 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBCookiePersistenceProfileCreateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform created",
+	"cookie_mode":        "INSERT",
+	"cookie_name":        "test-create",
+	"cookie_fallback":    "false",
+	"cookie_garble":      "false",
+	"persistence_shared": "true",
+}
+
+var accTestPolicyLBCookiePersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":       getAccTestResourceName(),
+	"description":        "terraform updated",
+	"cookie_mode":        "PREFIX",
+	"cookie_name":        "test-update",
+	"cookie_fallback":    "true",
+	"cookie_garble":      "true",
+	"persistence_shared": "false",
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state, accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBCookiePersistenceProfileCreateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.cookie_domain", ".example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.cookie_path", "/app"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.cookie_httponly", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.cookie_secure", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.cookie_expiry_type", "SESSION_COOKIE_TIME"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.max_idle_time", "100"),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.0.max_life_time", "200"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_mode"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "insert_mode_params.#", "0"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBCookiePersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBCookiePersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_lb_cookie_persistence_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBCookiePersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LBCookiePersistenceProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBCookiePersistenceProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBCookiePersistenceProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name       = "%s"
+  description        = "%s"
+  cookie_mode        = "%s"
+  cookie_name        = "%s"
+  cookie_fallback    = %s
+  cookie_garble      = %s
+  persistence_shared = %s
+  %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["cookie_mode"], attrMap["cookie_name"], attrMap["cookie_fallback"], attrMap["cookie_garble"], attrMap["persistence_shared"], testAccNsxtPolicyLBCookiePersistenceProfileInsertParams(createFlow))
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name = "%s"
+  cookie_name  = "test"
+}`, accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileInsertParams(createFlow bool) string {
+	if !createFlow {
+		return ""
+	}
+	return `
+  insert_mode_params {
+    cookie_domain      = ".example.com"
+    cookie_path        = "/app"
+    cookie_httponly    = true
+    cookie_secure      = true
+    cookie_expiry_type = "SESSION_COOKIE_TIME"
+    max_idle_time      = 100
+    max_life_time      = 200
+  }`
+}
@@ -0,0 +1,165 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBFastTcpApplicationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBFastTcpApplicationProfileCreate,
+		Read:   resourceNsxtPolicyLBFastTcpApplicationProfileRead,
+		Update: resourceNsxtPolicyLBFastTcpApplicationProfileUpdate,
+		Delete: resourceNsxtPolicyLBFastTcpApplicationProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"close_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long a closed TCP connection should be kept for this application before cleaning up the connection",
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long an idle TCP connection in ESTABLISHED state should be kept for this application before cleaning up",
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ha_flow_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "If enabled, all the flows to the bounded virtual server are mirrored to the standby node",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBAppProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	closeTimeout := int64(d.Get("close_timeout").(int))
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	haFlowMirroringEnabled := d.Get("ha_flow_mirroring_enabled").(bool)
+	obj := model.LBFastTcpProfile{
+		DisplayName:            &displayName,
+		Description:            &description,
+		Tags:                   tags,
+		CloseTimeout:           &closeTimeout,
+		IdleTimeout:            &idleTimeout,
+		HaFlowMirroringEnabled: &haFlowMirroringEnabled,
+		ResourceType:           model.LBAppProfile_RESOURCE_TYPE_LBFASTTCPPROFILE,
+	}
+
+	log.Printf("[INFO] Patching LBFastTcpProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBFastTcpProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBFastTcpProfile %s", errs[0])
+	}
+
+	client := infra.NewLbAppProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBFastTcpApplicationProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBFastTcpApplicationProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBFastTcpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBFastTcpApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastTcpProfile ID")
+	}
+
+	client := infra.NewLbAppProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBFastTcpProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBFastTcpProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBAppProfile with id %s is not of type LBFastTcpProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBFastTcpProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("close_timeout", profile.CloseTimeout)
+	d.Set("idle_timeout", profile.IdleTimeout)
+	d.Set("ha_flow_mirroring_enabled", profile.HaFlowMirroringEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastTcpProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBFastTcpApplicationProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBFastTcpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBFastTcpApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastTcpApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyLBAppProfileDelete(d, m)
+}
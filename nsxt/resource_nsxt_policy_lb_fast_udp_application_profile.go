@@ -0,0 +1,155 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBFastUdpApplicationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBFastUdpApplicationProfileCreate,
+		Read:   resourceNsxtPolicyLBFastUdpApplicationProfileRead,
+		Update: resourceNsxtPolicyLBFastUdpApplicationProfileUpdate,
+		Delete: resourceNsxtPolicyLBFastUdpApplicationProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"idle_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to specify how long an idle UDP flow should be kept for this application before cleaning up",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"flow_mirroring_enabled": {
+				Type:        schema.TypeBool,
+				Description: "If enabled, all the flows to the bounded virtual server are mirrored to the standby node",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyLBAppProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	idleTimeout := int64(d.Get("idle_timeout").(int))
+	flowMirroringEnabled := d.Get("flow_mirroring_enabled").(bool)
+	obj := model.LBFastUdpProfile{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		IdleTimeout:          &idleTimeout,
+		FlowMirroringEnabled: &flowMirroringEnabled,
+		ResourceType:         model.LBAppProfile_RESOURCE_TYPE_LBFASTUDPPROFILE,
+	}
+
+	log.Printf("[INFO] Patching LBFastUdpProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBFastUdpProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBFastUdpProfile %s", errs[0])
+	}
+
+	client := infra.NewLbAppProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBFastUdpApplicationProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBFastUdpApplicationProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBFastUdpProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBFastUdpApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastUdpProfile ID")
+	}
+
+	client := infra.NewLbAppProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBFastUdpProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBFastUdpProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBAppProfile with id %s is not of type LBFastUdpProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBFastUdpProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("idle_timeout", profile.IdleTimeout)
+	d.Set("flow_mirroring_enabled", profile.FlowMirroringEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBFastUdpProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBFastUdpApplicationProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBFastUdpProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBFastUdpApplicationProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBFastUdpApplicationProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyLBAppProfileDelete(d, m)
+}
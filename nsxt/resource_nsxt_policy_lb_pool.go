@@ -8,13 +8,16 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 )
 
@@ -81,6 +84,18 @@ func resourceNsxtPolicyLBPool() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"snat": getPolicyPoolSnatSchema(),
+			"graceful_drain_enabled": {
+				Type:        schema.TypeBool,
+				Description: "When members are removed, gracefully disable them and wait for their sessions to drain before removing them from the pool",
+				Optional:    true,
+			},
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds to wait for removed members to drain, applicable when graceful_drain_enabled is set",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 }
@@ -154,7 +169,10 @@ func getPolicyPoolSnatSchema() *schema.Schema {
 }
 
 func getPolicyPoolMembersFromSchema(d *schema.ResourceData) []model.LBPoolMember {
-	members := d.Get("member").([]interface{})
+	return getPolicyPoolMembersFromList(d.Get("member").([]interface{}))
+}
+
+func getPolicyPoolMembersFromList(members []interface{}) []model.LBPoolMember {
 	var memberList []model.LBPoolMember
 	for _, member := range members {
 		data := member.(map[string]interface{})
@@ -560,6 +578,15 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error obtaining LBPool ID")
 	}
 
+	revision := int64(d.Get("revision").(int))
+	if d.Get("graceful_drain_enabled").(bool) && d.HasChange("member") {
+		var err error
+		revision, err = resourceNsxtPolicyLBPoolDrainRemovedMembers(d, m, id, revision)
+		if err != nil {
+			return handleUpdateError("LBPool", id, err)
+		}
+	}
+
 	// Read the rest of the configured parameters
 	description := d.Get("description").(string)
 	displayName := d.Get("display_name").(string)
@@ -581,7 +608,6 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 	tcpMultiplexingEnabled := d.Get("tcp_multiplexing_enabled").(bool)
 	tcpMultiplexingNumber := int64(d.Get("tcp_multiplexing_number").(int))
 	minActiveMembers := int64(d.Get("min_active_members").(int))
-	revision := int64(d.Get("revision").(int))
 
 	obj := model.LBPool{
 		DisplayName:            &displayName,
@@ -607,6 +633,196 @@ func resourceNsxtPolicyLBPoolUpdate(d *schema.ResourceData, m interface{}) error
 	return resourceNsxtPolicyLBPoolRead(d, m)
 }
 
+func getPolicyPoolMemberKey(member model.LBPoolMember) string {
+	key := ""
+	if member.IpAddress != nil {
+		key = *member.IpAddress
+	}
+	if member.Port != nil {
+		key += ":" + *member.Port
+	}
+	return key
+}
+
+// Virtual server consumes the pool either directly, as sorry pool, or via select pool rule action
+func isPolicyLBPoolUsedByVirtualServer(vs model.LBVirtualServer, poolPath string) bool {
+	if (vs.PoolPath != nil && *vs.PoolPath == poolPath) || (vs.SorryPoolPath != nil && *vs.SorryPoolPath == poolPath) {
+		return true
+	}
+
+	converter := bindings.NewTypeConverter()
+	for _, rule := range vs.Rules {
+		for _, action := range rule.Actions {
+			basicType, errs := converter.ConvertToGolang(action, model.LBRuleActionBindingType())
+			if len(errs) > 0 || basicType.(model.LBRuleAction).Type_ != model.LBRuleAction_TYPE_LBSELECTPOOLACTION {
+				continue
+			}
+			specificType, errs := converter.ConvertToGolang(action, model.LBSelectPoolActionBindingType())
+			if len(errs) > 0 {
+				continue
+			}
+			poolID := specificType.(model.LBSelectPoolAction).PoolId
+			if poolID != nil && *poolID == poolPath {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Collect LB services that consume the pool via virtual servers
+func getPolicyLBServiceIDsForPool(connector client.Connector, poolPath string) ([]string, error) {
+	client := infra.NewLbVirtualServersClient(connector)
+	var serviceIDs []string
+	var cursor *string
+	for {
+		vsList, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, vs := range vsList.Results {
+			if vs.LbServicePath == nil || *vs.LbServicePath == "" {
+				continue
+			}
+			serviceID := getPolicyIDFromPath(*vs.LbServicePath)
+			if isPolicyLBPoolUsedByVirtualServer(vs, poolPath) && !stringInList(serviceID, serviceIDs) {
+				serviceIDs = append(serviceIDs, serviceID)
+			}
+		}
+		cursor = vsList.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			break
+		}
+	}
+
+	return serviceIDs, nil
+}
+
+func getPolicyLBPoolMembersCurrentSessions(connector client.Connector, serviceIDs []string, poolID string, enforcementPointPath string, memberKeys []string) (int64, error) {
+	converter := bindings.NewTypeConverter()
+	client := lb_pools.NewStatisticsClient(connector)
+	var sessions int64
+	for _, serviceID := range serviceIDs {
+		statistics, err := client.Get(serviceID, poolID, &enforcementPointPath, nil)
+		if err != nil {
+			return 0, err
+		}
+		for _, result := range statistics.Results {
+			dataValue, errs := converter.ConvertToGolang(result, model.LBPoolStatisticsBindingType())
+			if len(errs) > 0 {
+				return 0, errs[0]
+			}
+			poolStatistics := dataValue.(model.LBPoolStatistics)
+			for _, member := range poolStatistics.Members {
+				key := getPolicyPoolMemberKey(model.LBPoolMember{IpAddress: member.IpAddress, Port: member.Port})
+				if !stringInList(key, memberKeys) || member.Statistics == nil || member.Statistics.CurrentSessions == nil {
+					continue
+				}
+				sessions += *member.Statistics.CurrentSessions
+			}
+		}
+	}
+
+	return sessions, nil
+}
+
+// Set members that are about to be removed to GRACEFUL_DISABLED state and wait for
+// their sessions to drain. Returns revision of the pool after the intermediate update.
+func resourceNsxtPolicyLBPoolDrainRemovedMembers(d *schema.ResourceData, m interface{}, id string, revision int64) (int64, error) {
+	oldMembers, newMembers := d.GetChange("member")
+	var newKeys []string
+	for _, member := range getPolicyPoolMembersFromList(newMembers.([]interface{})) {
+		newKeys = append(newKeys, getPolicyPoolMemberKey(member))
+	}
+
+	var drainKeys []string
+	members := getPolicyPoolMembersFromList(oldMembers.([]interface{}))
+	for i, member := range members {
+		key := getPolicyPoolMemberKey(member)
+		if stringInList(key, newKeys) {
+			continue
+		}
+		drainKeys = append(drainKeys, key)
+		adminState := model.LBPoolMember_ADMIN_STATE_GRACEFUL_DISABLED
+		members[i].AdminState = &adminState
+	}
+
+	if len(drainKeys) == 0 {
+		return revision, nil
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewLbPoolsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return revision, err
+	}
+	obj.Members = members
+	log.Printf("[INFO] Gracefully disabling members %v of LBPool %s", drainKeys, id)
+	obj, err = client.Update(id, obj)
+	if err != nil {
+		return revision, err
+	}
+
+	// On failure to track sessions, members are restored to their original
+	// admin state, so that the pool is not left with disabled members
+	restoreMembers := func(drainErr error) (int64, error) {
+		log.Printf("[WARNING] Failed to drain members %v of LBPool %s, restoring them: %v", drainKeys, id, drainErr)
+		restoreObj, err := client.Get(id)
+		if err != nil {
+			return *obj.Revision, drainErr
+		}
+		restoreObj.Members = getPolicyPoolMembersFromList(oldMembers.([]interface{}))
+		restoreObj, err = client.Update(id, restoreObj)
+		if err != nil {
+			log.Printf("[WARNING] Failed to restore members of LBPool %s: %v", id, err)
+			return *obj.Revision, drainErr
+		}
+		return *restoreObj.Revision, drainErr
+	}
+
+	serviceIDs, err := getPolicyLBServiceIDsForPool(connector, *obj.Path)
+	if err != nil {
+		return restoreMembers(err)
+	}
+	if len(serviceIDs) == 0 {
+		// Pool is not consumed by any LB service, nothing to drain
+		return *obj.Revision, nil
+	}
+
+	timeout := d.Get("drain_timeout").(int)
+
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"draining"},
+		Target:  []string{"drained"},
+		Refresh: func() (interface{}, string, error) {
+			sessions, err := getPolicyLBPoolMembersCurrentSessions(connector, serviceIDs, id, enforcementPointPath, drainKeys)
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] LBPool %s members %v have %d current sessions", id, drainKeys, sessions)
+			if sessions > 0 {
+				return sessions, "draining", nil
+			}
+			return sessions, "drained", nil
+		},
+		Timeout:    time.Duration(timeout) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      1 * time.Second,
+	}
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			return restoreMembers(err)
+		}
+		log.Printf("[WARNING] Timed out waiting for members %v of LBPool %s to drain, removing them", drainKeys, id)
+	}
+
+	return *obj.Revision, nil
+}
+
 func resourceNsxtPolicyLBPoolDelete(d *schema.ResourceData, m interface{}) error {
 	id := d.Id()
 	if id == "" {
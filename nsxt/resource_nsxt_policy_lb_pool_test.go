@@ -203,6 +203,38 @@ func TestAccResourceNsxtPolicyLBPool_snat(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyLBPool_gracefulDrain(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_pool.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPoolCheckDestroy(state, accTestPolicyLBPoolCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBPoolDrainTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "graceful_drain_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "drain_timeout", "30"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBPoolDrainTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPoolExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.admin_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyLBPool_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_lb_pool.test"
@@ -403,6 +435,59 @@ data "nsxt_policy_realization_info" "realization_info" {
 }`, attrMap["display_name"], attrMap["description"], snatType, ipAddresses)
 }
 
+func testAccNsxtPolicyLBPoolDrainTemplate(withSecondMember bool) string {
+	secondMember := ""
+	if withSecondMember {
+		secondMember = `
+  member {
+    ip_address = "5.5.5.3"
+    port       = "80"
+  }`
+	}
+	// Pool is consumed by LB service via virtual server, so that removed
+	// members are drained before removal
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_lb_app_profile" "default_http" {
+  type         = "HTTP"
+  display_name = "default-http-lb-app-profile"
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "terraform-lb-drain-test"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_lb_service" "test" {
+  display_name      = "terraform-lb-drain-test"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name           = "%s"
+  graceful_drain_enabled = true
+  drain_timeout          = 30
+
+  member {
+    ip_address = "5.5.5.5"
+    port       = "80"
+  }
+  %s
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "terraform-lb-drain-test"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_http.path
+  service_path             = nsxt_policy_lb_service.test.path
+  pool_path                = nsxt_policy_lb_pool.test.path
+  ip_address               = "1.1.1.1"
+  ports                    = ["80"]
+}`, getEdgeClusterName(), accTestPolicyLBPoolCreateAttributes["display_name"], secondMember)
+}
+
 func testAccNsxtPolicyLBPoolMinimalistic() string {
 	return fmt.Sprintf(`
 resource "nsxt_policy_group" "test" {
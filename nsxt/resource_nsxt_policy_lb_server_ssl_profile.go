@@ -0,0 +1,192 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var lBServerSslProfileCipherGroupLabelValues = []string{
+	model.LBServerSslProfile_CIPHER_GROUP_LABEL_HIGH_COMPATIBILITY,
+	model.LBServerSslProfile_CIPHER_GROUP_LABEL_HIGH_SECURITY,
+	model.LBServerSslProfile_CIPHER_GROUP_LABEL_CUSTOM,
+	model.LBServerSslProfile_CIPHER_GROUP_LABEL_BALANCED,
+}
+
+func resourceNsxtPolicyLBServerSslProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBServerSslProfileCreate,
+		Read:   resourceNsxtPolicyLBServerSslProfileRead,
+		Update: resourceNsxtPolicyLBServerSslProfileUpdate,
+		Delete: resourceNsxtPolicyLBServerSslProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"cipher_group_label": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(lBServerSslProfileCipherGroupLabelValues, false),
+				Optional:     true,
+				Default:      model.LBServerSslProfile_CIPHER_GROUP_LABEL_BALANCED,
+				Description:  "A label of cipher group which is mostly consumed by GUI. Default value is BALANCED.",
+			},
+			"ciphers": getSSLCiphersSchema(),
+			"is_fips": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "This flag is set to true when all the ciphers and protocols are FIPS compliant. It is set to false when one of the ciphers or protocols are not FIPS compliant.",
+			},
+			"is_secure": getIsSecureSchema(),
+			"protocols": getSSLProtocolsSchema(),
+			"session_cache_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to true, SSL session caching allows SSL client and server to reuse previously negotiated security parameters avoiding the expensive public key operation during handshake.",
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyLBServerSslProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	var err error
+	client := infra.NewLbServerSslProfilesClient(connector)
+	_, err = client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyLBServerSslProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	cipherGroupLabel := d.Get("cipher_group_label").(string)
+	ciphers := getStringListFromSchemaSet(d, "ciphers")
+	protocols := getStringListFromSchemaSet(d, "protocols")
+	sessionCacheEnabled := d.Get("session_cache_enabled").(bool)
+
+	obj := model.LBServerSslProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		CipherGroupLabel:    &cipherGroupLabel,
+		Ciphers:             ciphers,
+		Protocols:           protocols,
+		SessionCacheEnabled: &sessionCacheEnabled,
+	}
+
+	log.Printf("[INFO] Patching LBServerSslProfile with ID %s", id)
+
+	client := infra.NewLbServerSslProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyLBServerSslProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBServerSslProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBServerSslProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBServerSslProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBServerSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBServerSslProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	var obj model.LBServerSslProfile
+	client := infra.NewLbServerSslProfilesClient(connector)
+	var err error
+	obj, err = client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBServerSslProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("cipher_group_label", obj.CipherGroupLabel)
+	d.Set("ciphers", obj.Ciphers)
+	d.Set("is_fips", obj.IsFips)
+	d.Set("is_secure", obj.IsSecure)
+	d.Set("protocols", obj.Protocols)
+	d.Set("session_cache_enabled", obj.SessionCacheEnabled)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBServerSslProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBServerSslProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBServerSslProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBServerSslProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBServerSslProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBServerSslProfile ID")
+	}
+
+	forceParam := true
+	connector := getPolicyConnector(m)
+	var err error
+	client := infra.NewLbServerSslProfilesClient(connector)
+	err = client.Delete(id, &forceParam)
+
+	if err != nil {
+		return handleDeleteError("LBServerSslProfile", id, err)
+	}
+
+	return nil
+}
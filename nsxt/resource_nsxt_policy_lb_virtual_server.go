@@ -624,12 +624,15 @@ func getPolicyLbRuleIPConditionSchema() *schema.Schema {
 				"inverse": getLbRuleInverseSchema(),
 				"source_address": {
 					Type:         schema.TypeString,
+					Description:  "Source IP address, range or subnet of HTTP message",
 					Optional:     true,
-					ValidateFunc: validateSingleIP(),
+					ValidateFunc: validateCidrOrIPOrRange(),
 				},
 				"group_path": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:         schema.TypeString,
+					Description:  "Grouping object path to match source IP address of HTTP message",
+					Optional:     true,
+					ValidateFunc: validatePolicyPath(),
 				},
 			},
 		},
@@ -1041,328 +1044,332 @@ func setPolicyAccessListControlInSchema(d *schema.ResourceData, control *model.L
 }
 
 func setPolicyLbRulesInSchema(d *schema.ResourceData, rules []model.LBRule) {
-	converter := bindings.NewTypeConverter()
-
 	var ruleList []interface{}
 	for _, rule := range rules {
-		ruleElem := make(map[string]interface{})
-		if rule.DisplayName != nil {
-			ruleElem["display_name"] = *rule.DisplayName
-		}
-		if rule.MatchStrategy != nil {
-			ruleElem["match_strategy"] = *rule.MatchStrategy
-		}
-		if rule.Phase != nil {
-			ruleElem["phase"] = *rule.Phase
-		}
+		ruleList = append(ruleList, getPolicyLbRuleSchemaMap(rule))
+	}
 
-		// Actions
-		var connectionDropActionList []interface{}
-		var selectPoolActionList []interface{}
-		var httpRedirectActionList []interface{}
-		var httpRequestURIRewriteActionList []interface{}
-		var httpRequestHeaderRewriteActionList []interface{}
-		var httpRejectActionList []interface{}
-		var httpResponseHeaderRewriteActionList []interface{}
-		var httpRequestHeaderDeleteActionList []interface{}
-		var httpResponseHeaderDeleteActionList []interface{}
-		var variableAssignmentActionList []interface{}
-		var variablePersistenceOnActionList []interface{}
-		var variablePersistenceLearnActionList []interface{}
-		var jwtAuthActionList []interface{}
-		var sslModeSelectionActionList []interface{}
-
-		for _, action := range rule.Actions {
-			actionElem := make(map[string]interface{})
-
-			basicType, _ := converter.ConvertToGolang(action, model.LBRuleActionBindingType())
-			actionType := basicType.(model.LBRuleAction).Type_
-
-			if actionType == model.LBRuleAction_TYPE_LBCONNECTIONDROPACTION {
-				actionElem["_dummy"] = "dummy_value_to_indicate_presence_of_section"
-				connectionDropActionList = append(connectionDropActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBSELECTPOOLACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBSelectPoolActionBindingType())
-				actionElem["pool_id"] = specificType.(model.LBSelectPoolAction).PoolId
-				selectPoolActionList = append(selectPoolActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpRedirectActionBindingType())
-				actionElem["redirect_status"] = specificType.(model.LBHttpRedirectAction).RedirectStatus
-				actionElem["redirect_url"] = specificType.(model.LBHttpRedirectAction).RedirectUrl
-				httpRedirectActionList = append(httpRedirectActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestUriRewriteActionBindingType())
-				actionElem["uri"] = specificType.(model.LBHttpRequestUriRewriteAction).Uri
-				actionElem["uri_arguments"] = specificType.(model.LBHttpRequestUriRewriteAction).UriArguments
-				httpRequestURIRewriteActionList = append(httpRequestURIRewriteActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestHeaderRewriteActionBindingType())
-				actionElem["header_name"] = specificType.(model.LBHttpRequestHeaderRewriteAction).HeaderName
-				actionElem["header_value"] = specificType.(model.LBHttpRequestHeaderRewriteAction).HeaderValue
-				httpRequestHeaderRewriteActionList = append(httpRequestHeaderRewriteActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPREJECTACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpRejectActionBindingType())
-				actionElem["reply_message"] = specificType.(model.LBHttpRejectAction).ReplyMessage
-				actionElem["reply_status"] = specificType.(model.LBHttpRejectAction).ReplyStatus
-				httpRejectActionList = append(httpRejectActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpResponseHeaderRewriteActionBindingType())
-				actionElem["header_name"] = specificType.(model.LBHttpResponseHeaderRewriteAction).HeaderName
-				actionElem["header_value"] = specificType.(model.LBHttpResponseHeaderRewriteAction).HeaderValue
-				httpResponseHeaderRewriteActionList = append(httpResponseHeaderRewriteActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestHeaderDeleteActionBindingType())
-				actionElem["header_name"] = specificType.(model.LBHttpRequestHeaderDeleteAction).HeaderName
-				httpRequestHeaderDeleteActionList = append(httpRequestHeaderDeleteActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBHttpResponseHeaderDeleteActionBindingType())
-				actionElem["header_name"] = specificType.(model.LBHttpResponseHeaderDeleteAction).HeaderName
-				httpResponseHeaderDeleteActionList = append(httpResponseHeaderDeleteActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBVariableAssignmentActionBindingType())
-				actionElem["variable_name"] = specificType.(model.LBVariableAssignmentAction).VariableName
-				actionElem["variable_value"] = specificType.(model.LBVariableAssignmentAction).VariableValue
-				variableAssignmentActionList = append(variableAssignmentActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBVariablePersistenceOnActionBindingType())
-				actionElem["persistence_profile_path"] = specificType.(model.LBVariablePersistenceOnAction).PersistenceProfilePath
-				actionElem["variable_hash_enabled"] = specificType.(model.LBVariablePersistenceOnAction).VariableHashEnabled
-				actionElem["variable_name"] = specificType.(model.LBVariablePersistenceOnAction).VariableName
-				variablePersistenceOnActionList = append(variablePersistenceOnActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBVariablePersistenceLearnActionBindingType())
-				actionElem["persistence_profile_path"] = specificType.(model.LBVariablePersistenceLearnAction).PersistenceProfilePath
-				actionElem["variable_hash_enabled"] = specificType.(model.LBVariablePersistenceLearnAction).VariableHashEnabled
-				actionElem["variable_name"] = specificType.(model.LBVariablePersistenceLearnAction).VariableName
-				variablePersistenceLearnActionList = append(variablePersistenceLearnActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBSslModeSelectionActionBindingType())
-				actionElem["ssl_mode"] = specificType.(model.LBSslModeSelectionAction).SslMode
-				sslModeSelectionActionList = append(sslModeSelectionActionList, actionElem)
-			} else if actionType == model.LBRuleAction_TYPE_LBJWTAUTHACTION {
-				specificType, _ := converter.ConvertToGolang(action, model.LBJwtAuthActionBindingType())
-				actionElem["pass_jwt_to_pool"] = specificType.(model.LBJwtAuthAction).PassJwtToPool
-				actionElem["realm"] = specificType.(model.LBJwtAuthAction).Realm
-
-				var keyList []interface{}
-				keyElem := make(map[string]interface{})
-
-				key := specificType.(model.LBJwtAuthAction).Key
-				basicKeyType, _ := converter.ConvertToGolang(key, model.LBJwtKeyBindingType())
-				keyType := basicKeyType.(model.LBJwtKey).Type_
-
-				if keyType == model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY {
-					specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtCertificateKeyBindingType())
-					keyElem["certificate_path"] = specificKeyType.(model.LBJwtCertificateKey).CertificatePath
-				} else if keyType == model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY {
-					keyElem["symmetric_key"] = "dummy"
-				} else if keyType == model.LBJwtKey_TYPE_LBJWTPUBLICKEY {
-					specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtPublicKeyBindingType())
-					keyElem["public_key_content"] = specificKeyType.(model.LBJwtPublicKey).PublicKeyContent
-				}
+	err := d.Set("rule", ruleList)
+	if err != nil {
+		log.Printf("[WARNING] Failed to set rule list in schema: %v", err)
+	}
+
+}
 
-				keyList = append(keyList, keyElem)
-				actionElem["key"] = schema.NewSet(resourceKeyValueHash, keyList)
+func getPolicyLbRuleSchemaMap(rule model.LBRule) map[string]interface{} {
+	converter := bindings.NewTypeConverter()
 
-				var tokens []interface{}
-				for _, v := range specificType.(model.LBJwtAuthAction).Tokens {
-					tokens = append(tokens, v)
-				}
-				actionElem["tokens"] = tokens
+	ruleElem := make(map[string]interface{})
+	if rule.DisplayName != nil {
+		ruleElem["display_name"] = *rule.DisplayName
+	}
+	if rule.MatchStrategy != nil {
+		ruleElem["match_strategy"] = *rule.MatchStrategy
+	}
+	if rule.Phase != nil {
+		ruleElem["phase"] = *rule.Phase
+	}
+
+	// Actions
+	var connectionDropActionList []interface{}
+	var selectPoolActionList []interface{}
+	var httpRedirectActionList []interface{}
+	var httpRequestURIRewriteActionList []interface{}
+	var httpRequestHeaderRewriteActionList []interface{}
+	var httpRejectActionList []interface{}
+	var httpResponseHeaderRewriteActionList []interface{}
+	var httpRequestHeaderDeleteActionList []interface{}
+	var httpResponseHeaderDeleteActionList []interface{}
+	var variableAssignmentActionList []interface{}
+	var variablePersistenceOnActionList []interface{}
+	var variablePersistenceLearnActionList []interface{}
+	var jwtAuthActionList []interface{}
+	var sslModeSelectionActionList []interface{}
+
+	for _, action := range rule.Actions {
+		actionElem := make(map[string]interface{})
 
-				jwtAuthActionList = append(jwtAuthActionList, actionElem)
+		basicType, _ := converter.ConvertToGolang(action, model.LBRuleActionBindingType())
+		actionType := basicType.(model.LBRuleAction).Type_
+
+		if actionType == model.LBRuleAction_TYPE_LBCONNECTIONDROPACTION {
+			actionElem["_dummy"] = "dummy_value_to_indicate_presence_of_section"
+			connectionDropActionList = append(connectionDropActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBSELECTPOOLACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBSelectPoolActionBindingType())
+			actionElem["pool_id"] = specificType.(model.LBSelectPoolAction).PoolId
+			selectPoolActionList = append(selectPoolActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpRedirectActionBindingType())
+			actionElem["redirect_status"] = specificType.(model.LBHttpRedirectAction).RedirectStatus
+			actionElem["redirect_url"] = specificType.(model.LBHttpRedirectAction).RedirectUrl
+			httpRedirectActionList = append(httpRedirectActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestUriRewriteActionBindingType())
+			actionElem["uri"] = specificType.(model.LBHttpRequestUriRewriteAction).Uri
+			actionElem["uri_arguments"] = specificType.(model.LBHttpRequestUriRewriteAction).UriArguments
+			httpRequestURIRewriteActionList = append(httpRequestURIRewriteActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestHeaderRewriteActionBindingType())
+			actionElem["header_name"] = specificType.(model.LBHttpRequestHeaderRewriteAction).HeaderName
+			actionElem["header_value"] = specificType.(model.LBHttpRequestHeaderRewriteAction).HeaderValue
+			httpRequestHeaderRewriteActionList = append(httpRequestHeaderRewriteActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPREJECTACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpRejectActionBindingType())
+			actionElem["reply_message"] = specificType.(model.LBHttpRejectAction).ReplyMessage
+			actionElem["reply_status"] = specificType.(model.LBHttpRejectAction).ReplyStatus
+			httpRejectActionList = append(httpRejectActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpResponseHeaderRewriteActionBindingType())
+			actionElem["header_name"] = specificType.(model.LBHttpResponseHeaderRewriteAction).HeaderName
+			actionElem["header_value"] = specificType.(model.LBHttpResponseHeaderRewriteAction).HeaderValue
+			httpResponseHeaderRewriteActionList = append(httpResponseHeaderRewriteActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpRequestHeaderDeleteActionBindingType())
+			actionElem["header_name"] = specificType.(model.LBHttpRequestHeaderDeleteAction).HeaderName
+			httpRequestHeaderDeleteActionList = append(httpRequestHeaderDeleteActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBHttpResponseHeaderDeleteActionBindingType())
+			actionElem["header_name"] = specificType.(model.LBHttpResponseHeaderDeleteAction).HeaderName
+			httpResponseHeaderDeleteActionList = append(httpResponseHeaderDeleteActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBVariableAssignmentActionBindingType())
+			actionElem["variable_name"] = specificType.(model.LBVariableAssignmentAction).VariableName
+			actionElem["variable_value"] = specificType.(model.LBVariableAssignmentAction).VariableValue
+			variableAssignmentActionList = append(variableAssignmentActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBVariablePersistenceOnActionBindingType())
+			actionElem["persistence_profile_path"] = specificType.(model.LBVariablePersistenceOnAction).PersistenceProfilePath
+			actionElem["variable_hash_enabled"] = specificType.(model.LBVariablePersistenceOnAction).VariableHashEnabled
+			actionElem["variable_name"] = specificType.(model.LBVariablePersistenceOnAction).VariableName
+			variablePersistenceOnActionList = append(variablePersistenceOnActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBVariablePersistenceLearnActionBindingType())
+			actionElem["persistence_profile_path"] = specificType.(model.LBVariablePersistenceLearnAction).PersistenceProfilePath
+			actionElem["variable_hash_enabled"] = specificType.(model.LBVariablePersistenceLearnAction).VariableHashEnabled
+			actionElem["variable_name"] = specificType.(model.LBVariablePersistenceLearnAction).VariableName
+			variablePersistenceLearnActionList = append(variablePersistenceLearnActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBSslModeSelectionActionBindingType())
+			actionElem["ssl_mode"] = specificType.(model.LBSslModeSelectionAction).SslMode
+			sslModeSelectionActionList = append(sslModeSelectionActionList, actionElem)
+		} else if actionType == model.LBRuleAction_TYPE_LBJWTAUTHACTION {
+			specificType, _ := converter.ConvertToGolang(action, model.LBJwtAuthActionBindingType())
+			actionElem["pass_jwt_to_pool"] = specificType.(model.LBJwtAuthAction).PassJwtToPool
+			actionElem["realm"] = specificType.(model.LBJwtAuthAction).Realm
+
+			var keyList []interface{}
+			keyElem := make(map[string]interface{})
+
+			key := specificType.(model.LBJwtAuthAction).Key
+			basicKeyType, _ := converter.ConvertToGolang(key, model.LBJwtKeyBindingType())
+			keyType := basicKeyType.(model.LBJwtKey).Type_
+
+			if keyType == model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY {
+				specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtCertificateKeyBindingType())
+				keyElem["certificate_path"] = specificKeyType.(model.LBJwtCertificateKey).CertificatePath
+			} else if keyType == model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY {
+				keyElem["symmetric_key"] = "dummy"
+			} else if keyType == model.LBJwtKey_TYPE_LBJWTPUBLICKEY {
+				specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtPublicKeyBindingType())
+				keyElem["public_key_content"] = specificKeyType.(model.LBJwtPublicKey).PublicKeyContent
 			}
-		}
 
-		actionElem := make(map[string]interface{})
-		actionElem["connection_drop"] = connectionDropActionList
-		actionElem["select_pool"] = selectPoolActionList
-		actionElem["http_redirect"] = httpRedirectActionList
-		actionElem["http_request_uri_rewrite"] = httpRequestURIRewriteActionList
-		actionElem["http_request_header_rewrite"] = httpRequestHeaderRewriteActionList
-		actionElem["http_reject"] = httpRejectActionList
-		actionElem["http_response_header_rewrite"] = httpResponseHeaderRewriteActionList
-		actionElem["http_request_header_delete"] = httpRequestHeaderDeleteActionList
-		actionElem["http_response_header_delete"] = httpResponseHeaderDeleteActionList
-		actionElem["variable_assignment"] = variableAssignmentActionList
-		actionElem["variable_persistence_on"] = variablePersistenceOnActionList
-		actionElem["variable_persistence_learn"] = variablePersistenceLearnActionList
-		actionElem["jwt_auth"] = jwtAuthActionList
-		actionElem["ssl_mode_selection"] = sslModeSelectionActionList
-
-		var actionList []interface{}
-		actionList = append(actionList, actionElem)
-		ruleElem["action"] = actionList
-
-		// MatchConditions
-		var httpRequestBodyConditionList []interface{}
-		var httpRequestURIConditionList []interface{}
-		var httpRequestHeaderConditionList []interface{}
-		var httpRequestMethodConditionList []interface{}
-		var httpRequestURIArgumentsConditionList []interface{}
-		var httpRequestVersionConditionList []interface{}
-		var httpRequestCookieConditionList []interface{}
-		var httpResponseHeaderConditionList []interface{}
-		var tcpHeaderConditionList []interface{}
-		var ipHeaderConditionList []interface{}
-		var variableConditionList []interface{}
-		var httpSslConditionList []interface{}
-		var sslSniConditionList []interface{}
-
-		var conditionCount int
-		for _, condition := range rule.MatchConditions {
-			conditionCount = conditionCount + 1
-			conditionElem := make(map[string]interface{})
-
-			basicType, _ := converter.ConvertToGolang(condition, model.LBRuleConditionBindingType())
-			conditionType := basicType.(model.LBRuleCondition).Type_
-
-			if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestBodyConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestBodyCondition).CaseSensitive
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestBodyCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpRequestBodyCondition).MatchType
-				conditionElem["body_value"] = specificType.(model.LBHttpRequestBodyCondition).BodyValue
-				httpRequestBodyConditionList = append(httpRequestBodyConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestUriConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestUriCondition).CaseSensitive
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestUriCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpRequestUriCondition).MatchType
-				conditionElem["uri"] = specificType.(model.LBHttpRequestUriCondition).Uri
-				httpRequestURIConditionList = append(httpRequestURIConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestHeaderConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestHeaderCondition).CaseSensitive
-				conditionElem["header_name"] = specificType.(model.LBHttpRequestHeaderCondition).HeaderName
-				conditionElem["header_value"] = specificType.(model.LBHttpRequestHeaderCondition).HeaderValue
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestHeaderCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpRequestHeaderCondition).MatchType
-				httpRequestHeaderConditionList = append(httpRequestHeaderConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestMethodConditionBindingType())
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestMethodCondition).Inverse
-				conditionElem["method"] = specificType.(model.LBHttpRequestMethodCondition).Method
-				httpRequestMethodConditionList = append(httpRequestMethodConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestUriArgumentsConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestUriArgumentsCondition).CaseSensitive
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestUriArgumentsCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpRequestUriArgumentsCondition).MatchType
-				conditionElem["uri_arguments"] = specificType.(model.LBHttpRequestUriArgumentsCondition).UriArguments
-				httpRequestURIArgumentsConditionList = append(httpRequestURIArgumentsConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestVersionConditionBindingType())
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestVersionCondition).Inverse
-				conditionElem["version"] = specificType.(model.LBHttpRequestVersionCondition).Version
-				httpRequestVersionConditionList = append(httpRequestVersionConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestCookieConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestCookieCondition).CaseSensitive
-				conditionElem["cookie_name"] = specificType.(model.LBHttpRequestCookieCondition).CookieName
-				conditionElem["cookie_value"] = specificType.(model.LBHttpRequestCookieCondition).CookieValue
-				conditionElem["inverse"] = specificType.(model.LBHttpRequestCookieCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpRequestCookieCondition).MatchType
-				httpRequestCookieConditionList = append(httpRequestCookieConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpResponseHeaderConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBHttpResponseHeaderCondition).CaseSensitive
-				conditionElem["header_name"] = specificType.(model.LBHttpResponseHeaderCondition).HeaderName
-				conditionElem["header_value"] = specificType.(model.LBHttpResponseHeaderCondition).HeaderValue
-				conditionElem["inverse"] = specificType.(model.LBHttpResponseHeaderCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBHttpResponseHeaderCondition).MatchType
-				httpResponseHeaderConditionList = append(httpResponseHeaderConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBTcpHeaderConditionBindingType())
-				conditionElem["inverse"] = specificType.(model.LBTcpHeaderCondition).Inverse
-				conditionElem["source_port"] = specificType.(model.LBTcpHeaderCondition).SourcePort
-				tcpHeaderConditionList = append(tcpHeaderConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBIPHEADERCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBIpHeaderConditionBindingType())
-				conditionElem["group_path"] = specificType.(model.LBIpHeaderCondition).GroupPath
-				conditionElem["inverse"] = specificType.(model.LBIpHeaderCondition).Inverse
-				conditionElem["source_address"] = specificType.(model.LBIpHeaderCondition).SourceAddress
-				ipHeaderConditionList = append(ipHeaderConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBVARIABLECONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBVariableConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBVariableCondition).CaseSensitive
-				conditionElem["inverse"] = specificType.(model.LBVariableCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBVariableCondition).MatchType
-				conditionElem["variable_name"] = specificType.(model.LBVariableCondition).VariableName
-				conditionElem["variable_value"] = specificType.(model.LBVariableCondition).VariableValue
-				variableConditionList = append(variableConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBSSLSNICONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBSslSniConditionBindingType())
-				conditionElem["case_sensitive"] = specificType.(model.LBSslSniCondition).CaseSensitive
-				conditionElem["inverse"] = specificType.(model.LBSslSniCondition).Inverse
-				conditionElem["match_type"] = specificType.(model.LBSslSniCondition).MatchType
-				conditionElem["sni"] = specificType.(model.LBSslSniCondition).Sni
-				sslSniConditionList = append(sslSniConditionList, conditionElem)
-			} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION {
-				specificType, _ := converter.ConvertToGolang(condition, model.LBHttpSslConditionBindingType())
-				conditionElem["inverse"] = specificType.(model.LBHttpSslCondition).Inverse
-				conditionElem["session_reused"] = specificType.(model.LBHttpSslCondition).SessionReused
-				conditionElem["used_protocol"] = specificType.(model.LBHttpSslCondition).UsedProtocol
-				conditionElem["used_ssl_cipher"] = specificType.(model.LBHttpSslCondition).UsedSslCipher
-
-				issuerDn := specificType.(model.LBHttpSslCondition).ClientCertificateIssuerDn
-				var issuerDnList []interface{}
-				issuerElem := make(map[string]interface{})
-				issuerElem["case_sensitive"] = issuerDn.CaseSensitive
-				issuerElem["issuer_dn"] = issuerDn.IssuerDn
-				issuerElem["match_type"] = issuerDn.MatchType
-				issuerDnList = append(issuerDnList, issuerElem)
-				conditionElem["client_certificate_issuer_dn"] = schema.NewSet(resourceKeyValueHash, issuerDnList)
-
-				subjectDn := specificType.(model.LBHttpSslCondition).ClientCertificateSubjectDn
-				var subjectDnList []interface{}
-				subjectElem := make(map[string]interface{})
-				subjectElem["case_sensitive"] = subjectDn.CaseSensitive
-				subjectElem["subject_dn"] = subjectDn.SubjectDn
-				subjectElem["match_type"] = subjectDn.MatchType
-				subjectDnList = append(subjectDnList, subjectElem)
-				conditionElem["client_certificate_subject_dn"] = schema.NewSet(resourceKeyValueHash, subjectDnList)
-
-				var sslCiphers []interface{}
-				for _, v := range specificType.(model.LBHttpSslCondition).ClientSupportedSslCiphers {
-					sslCiphers = append(sslCiphers, v)
-				}
-				conditionElem["client_supported_ssl_ciphers"] = sslCiphers
+			keyList = append(keyList, keyElem)
+			actionElem["key"] = schema.NewSet(resourceKeyValueHash, keyList)
 
-				httpSslConditionList = append(httpSslConditionList, conditionElem)
+			var tokens []interface{}
+			for _, v := range specificType.(model.LBJwtAuthAction).Tokens {
+				tokens = append(tokens, v)
 			}
-		}
+			actionElem["tokens"] = tokens
 
-		// Optional argument, only set it if we get anything back
-		if conditionCount > 0 {
-			conditionElem := make((map[string]interface{}))
-			conditionElem["http_request_body"] = httpRequestBodyConditionList
-			conditionElem["http_request_uri"] = httpRequestURIConditionList
-			conditionElem["http_request_header"] = httpRequestHeaderConditionList
-			conditionElem["http_request_method"] = httpRequestMethodConditionList
-			conditionElem["http_request_uri_arguments"] = httpRequestURIArgumentsConditionList
-			conditionElem["http_request_version"] = httpRequestVersionConditionList
-			conditionElem["http_request_cookie"] = httpRequestCookieConditionList
-			conditionElem["http_response_header"] = httpResponseHeaderConditionList
-			conditionElem["tcp_header"] = tcpHeaderConditionList
-			conditionElem["ip_header"] = ipHeaderConditionList
-			conditionElem["variable"] = variableConditionList
-			conditionElem["http_ssl"] = httpSslConditionList
-			conditionElem["ssl_sni"] = sslSniConditionList
-
-			var conditionList []interface{}
-			conditionList = append(conditionList, conditionElem)
-			ruleElem["condition"] = conditionList
+			jwtAuthActionList = append(jwtAuthActionList, actionElem)
 		}
-
-		ruleList = append(ruleList, ruleElem)
 	}
 
-	err := d.Set("rule", ruleList)
-	if err != nil {
-		log.Printf("[WARNING] Failed to set rule list in schema: %v", err)
+	actionElem := make(map[string]interface{})
+	actionElem["connection_drop"] = connectionDropActionList
+	actionElem["select_pool"] = selectPoolActionList
+	actionElem["http_redirect"] = httpRedirectActionList
+	actionElem["http_request_uri_rewrite"] = httpRequestURIRewriteActionList
+	actionElem["http_request_header_rewrite"] = httpRequestHeaderRewriteActionList
+	actionElem["http_reject"] = httpRejectActionList
+	actionElem["http_response_header_rewrite"] = httpResponseHeaderRewriteActionList
+	actionElem["http_request_header_delete"] = httpRequestHeaderDeleteActionList
+	actionElem["http_response_header_delete"] = httpResponseHeaderDeleteActionList
+	actionElem["variable_assignment"] = variableAssignmentActionList
+	actionElem["variable_persistence_on"] = variablePersistenceOnActionList
+	actionElem["variable_persistence_learn"] = variablePersistenceLearnActionList
+	actionElem["jwt_auth"] = jwtAuthActionList
+	actionElem["ssl_mode_selection"] = sslModeSelectionActionList
+
+	var actionList []interface{}
+	actionList = append(actionList, actionElem)
+	ruleElem["action"] = actionList
+
+	// MatchConditions
+	var httpRequestBodyConditionList []interface{}
+	var httpRequestURIConditionList []interface{}
+	var httpRequestHeaderConditionList []interface{}
+	var httpRequestMethodConditionList []interface{}
+	var httpRequestURIArgumentsConditionList []interface{}
+	var httpRequestVersionConditionList []interface{}
+	var httpRequestCookieConditionList []interface{}
+	var httpResponseHeaderConditionList []interface{}
+	var tcpHeaderConditionList []interface{}
+	var ipHeaderConditionList []interface{}
+	var variableConditionList []interface{}
+	var httpSslConditionList []interface{}
+	var sslSniConditionList []interface{}
+
+	var conditionCount int
+	for _, condition := range rule.MatchConditions {
+		conditionCount = conditionCount + 1
+		conditionElem := make(map[string]interface{})
+
+		basicType, _ := converter.ConvertToGolang(condition, model.LBRuleConditionBindingType())
+		conditionType := basicType.(model.LBRuleCondition).Type_
+
+		if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestBodyConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestBodyCondition).CaseSensitive
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestBodyCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpRequestBodyCondition).MatchType
+			conditionElem["body_value"] = specificType.(model.LBHttpRequestBodyCondition).BodyValue
+			httpRequestBodyConditionList = append(httpRequestBodyConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestUriConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestUriCondition).CaseSensitive
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestUriCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpRequestUriCondition).MatchType
+			conditionElem["uri"] = specificType.(model.LBHttpRequestUriCondition).Uri
+			httpRequestURIConditionList = append(httpRequestURIConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestHeaderConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestHeaderCondition).CaseSensitive
+			conditionElem["header_name"] = specificType.(model.LBHttpRequestHeaderCondition).HeaderName
+			conditionElem["header_value"] = specificType.(model.LBHttpRequestHeaderCondition).HeaderValue
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestHeaderCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpRequestHeaderCondition).MatchType
+			httpRequestHeaderConditionList = append(httpRequestHeaderConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestMethodConditionBindingType())
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestMethodCondition).Inverse
+			conditionElem["method"] = specificType.(model.LBHttpRequestMethodCondition).Method
+			httpRequestMethodConditionList = append(httpRequestMethodConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestUriArgumentsConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestUriArgumentsCondition).CaseSensitive
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestUriArgumentsCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpRequestUriArgumentsCondition).MatchType
+			conditionElem["uri_arguments"] = specificType.(model.LBHttpRequestUriArgumentsCondition).UriArguments
+			httpRequestURIArgumentsConditionList = append(httpRequestURIArgumentsConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestVersionConditionBindingType())
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestVersionCondition).Inverse
+			conditionElem["version"] = specificType.(model.LBHttpRequestVersionCondition).Version
+			httpRequestVersionConditionList = append(httpRequestVersionConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpRequestCookieConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpRequestCookieCondition).CaseSensitive
+			conditionElem["cookie_name"] = specificType.(model.LBHttpRequestCookieCondition).CookieName
+			conditionElem["cookie_value"] = specificType.(model.LBHttpRequestCookieCondition).CookieValue
+			conditionElem["inverse"] = specificType.(model.LBHttpRequestCookieCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpRequestCookieCondition).MatchType
+			httpRequestCookieConditionList = append(httpRequestCookieConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpResponseHeaderConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBHttpResponseHeaderCondition).CaseSensitive
+			conditionElem["header_name"] = specificType.(model.LBHttpResponseHeaderCondition).HeaderName
+			conditionElem["header_value"] = specificType.(model.LBHttpResponseHeaderCondition).HeaderValue
+			conditionElem["inverse"] = specificType.(model.LBHttpResponseHeaderCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBHttpResponseHeaderCondition).MatchType
+			httpResponseHeaderConditionList = append(httpResponseHeaderConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBTcpHeaderConditionBindingType())
+			conditionElem["inverse"] = specificType.(model.LBTcpHeaderCondition).Inverse
+			conditionElem["source_port"] = specificType.(model.LBTcpHeaderCondition).SourcePort
+			tcpHeaderConditionList = append(tcpHeaderConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBIPHEADERCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBIpHeaderConditionBindingType())
+			conditionElem["group_path"] = specificType.(model.LBIpHeaderCondition).GroupPath
+			conditionElem["inverse"] = specificType.(model.LBIpHeaderCondition).Inverse
+			conditionElem["source_address"] = specificType.(model.LBIpHeaderCondition).SourceAddress
+			ipHeaderConditionList = append(ipHeaderConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBVARIABLECONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBVariableConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBVariableCondition).CaseSensitive
+			conditionElem["inverse"] = specificType.(model.LBVariableCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBVariableCondition).MatchType
+			conditionElem["variable_name"] = specificType.(model.LBVariableCondition).VariableName
+			conditionElem["variable_value"] = specificType.(model.LBVariableCondition).VariableValue
+			variableConditionList = append(variableConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBSSLSNICONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBSslSniConditionBindingType())
+			conditionElem["case_sensitive"] = specificType.(model.LBSslSniCondition).CaseSensitive
+			conditionElem["inverse"] = specificType.(model.LBSslSniCondition).Inverse
+			conditionElem["match_type"] = specificType.(model.LBSslSniCondition).MatchType
+			conditionElem["sni"] = specificType.(model.LBSslSniCondition).Sni
+			sslSniConditionList = append(sslSniConditionList, conditionElem)
+		} else if conditionType == model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION {
+			specificType, _ := converter.ConvertToGolang(condition, model.LBHttpSslConditionBindingType())
+			conditionElem["inverse"] = specificType.(model.LBHttpSslCondition).Inverse
+			conditionElem["session_reused"] = specificType.(model.LBHttpSslCondition).SessionReused
+			conditionElem["used_protocol"] = specificType.(model.LBHttpSslCondition).UsedProtocol
+			conditionElem["used_ssl_cipher"] = specificType.(model.LBHttpSslCondition).UsedSslCipher
+
+			issuerDn := specificType.(model.LBHttpSslCondition).ClientCertificateIssuerDn
+			var issuerDnList []interface{}
+			issuerElem := make(map[string]interface{})
+			issuerElem["case_sensitive"] = issuerDn.CaseSensitive
+			issuerElem["issuer_dn"] = issuerDn.IssuerDn
+			issuerElem["match_type"] = issuerDn.MatchType
+			issuerDnList = append(issuerDnList, issuerElem)
+			conditionElem["client_certificate_issuer_dn"] = schema.NewSet(resourceKeyValueHash, issuerDnList)
+
+			subjectDn := specificType.(model.LBHttpSslCondition).ClientCertificateSubjectDn
+			var subjectDnList []interface{}
+			subjectElem := make(map[string]interface{})
+			subjectElem["case_sensitive"] = subjectDn.CaseSensitive
+			subjectElem["subject_dn"] = subjectDn.SubjectDn
+			subjectElem["match_type"] = subjectDn.MatchType
+			subjectDnList = append(subjectDnList, subjectElem)
+			conditionElem["client_certificate_subject_dn"] = schema.NewSet(resourceKeyValueHash, subjectDnList)
+
+			var sslCiphers []interface{}
+			for _, v := range specificType.(model.LBHttpSslCondition).ClientSupportedSslCiphers {
+				sslCiphers = append(sslCiphers, v)
+			}
+			conditionElem["client_supported_ssl_ciphers"] = sslCiphers
+
+			httpSslConditionList = append(httpSslConditionList, conditionElem)
+		}
 	}
 
+	// Optional argument, only set it if we get anything back
+	if conditionCount > 0 {
+		conditionElem := make((map[string]interface{}))
+		conditionElem["http_request_body"] = httpRequestBodyConditionList
+		conditionElem["http_request_uri"] = httpRequestURIConditionList
+		conditionElem["http_request_header"] = httpRequestHeaderConditionList
+		conditionElem["http_request_method"] = httpRequestMethodConditionList
+		conditionElem["http_request_uri_arguments"] = httpRequestURIArgumentsConditionList
+		conditionElem["http_request_version"] = httpRequestVersionConditionList
+		conditionElem["http_request_cookie"] = httpRequestCookieConditionList
+		conditionElem["http_response_header"] = httpResponseHeaderConditionList
+		conditionElem["tcp_header"] = tcpHeaderConditionList
+		conditionElem["ip_header"] = ipHeaderConditionList
+		conditionElem["variable"] = variableConditionList
+		conditionElem["http_ssl"] = httpSslConditionList
+		conditionElem["ssl_sni"] = sslSniConditionList
+
+		var conditionList []interface{}
+		conditionList = append(conditionList, conditionElem)
+		ruleElem["condition"] = conditionList
+	}
+
+	return ruleElem
 }
 
 func getRuleActionOrMethod(ruleData map[string]interface{}, key string, stringFields []string, boolFields []string, internalType string) []*data.StructValue {
@@ -1392,164 +1399,165 @@ func getPolicyLbRulesFromSchema(d *schema.ResourceData) []model.LBRule {
 	rules := d.Get("rule").([]interface{})
 
 	for _, rule := range rules {
-		ruleData := rule.(map[string]interface{})
-
-		displayName := ruleData["display_name"].(string)
-		matchStrategy := ruleData["match_strategy"].(string)
-		phase := ruleData["phase"].(string)
-
-		var actions []*data.StructValue
-
-		ruleActions := ruleData["action"]
-		for _, ruleAction := range ruleActions.([]interface{}) {
-
-			ruleAction := ruleAction.(map[string]interface{})
-
-			// Just strings and booleans, we use a helper function for there
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "connection_drop", []string{}, []string{}, model.LBRuleAction_TYPE_LBCONNECTIONDROPACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_redirect", []string{"redirect_status", "redirect_url"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_reject", []string{"reply_message", "reply_status"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREJECTACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_header_delete", []string{"header_name"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_header_rewrite", []string{"header_name", "header_value"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_uri_rewrite", []string{"uri", "uri_arguments"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_response_header_delete", []string{"header_name"}, []string{}, model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "http_response_header_rewrite", []string{"header_name", "header_value"}, []string{}, model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "select_pool", []string{"pool_id"}, []string{}, model.LBRuleAction_TYPE_LBSELECTPOOLACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "ssl_mode_selection", []string{"ssl_mode"}, []string{}, model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_assignment", []string{"variable_name", "variable_value"}, []string{}, model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_persistence_learn", []string{"persistence_profile_path", "variable_name"}, []string{"variable_hash_enabled"}, model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION)...)
-			actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_persistence_on", []string{"persistence_profile_path", "variable_name"}, []string{"variable_hash_enabled"}, model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION)...)
-
-			// more complicated actions
-			for _, action := range ruleAction["jwt_auth"].([]interface{}) {
-				actionData := action.(map[string]interface{})
-				var fields = make(map[string]data.DataValue)
-				fields["type"] = data.NewStringValue(model.LBRuleAction_TYPE_LBJWTAUTHACTION)
-				if actionData["realm"] != nil {
-					fields["realm"] = data.NewStringValue(actionData["realm"].(string))
-				}
-				if actionData["pass_jwt_to_pool"] != nil {
-					fields["pass_jwt_to_pool"] = data.NewBooleanValue(actionData["pass_jwt_to_pool"].(bool))
-				}
-				// I still haven't fully figured out why key comes as a (*schema.Set) but that's what we want,
-				// a set where no key can be there more than once
-				for _, key := range actionData["key"].(*schema.Set).List() {
-					keyData := key.(map[string]interface{})
-					var keyFields = make(map[string]data.DataValue)
-					if keyData["certificate_path"] != nil && keyData["certificate_path"].(string) != "" {
-						keyFields["certificate_path"] = data.NewStringValue(keyData["certificate_path"].(string))
-						keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY)
-					} else if keyData["public_key_content"] != nil && keyData["public_key_content"].(string) != "" {
-						keyFields["public_key_content"] = data.NewStringValue(keyData["public_key_content"].(string))
-						keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTPUBLICKEY)
-					} else if keyData["symmetric_key"] != nil && keyData["symmetric_key"].(string) != "" {
-						// the API only wants the marker id, no actual content parameters
-						keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY)
-					}
-					fields["key"] = data.NewStructValue("", keyFields)
+		ruleList = append(ruleList, getPolicyLbRuleFromSchemaMap(rule.(map[string]interface{})))
+	}
+	return ruleList
+}
+
+func getPolicyLbRuleFromSchemaMap(ruleData map[string]interface{}) model.LBRule {
+	displayName := ruleData["display_name"].(string)
+	matchStrategy := ruleData["match_strategy"].(string)
+	phase := ruleData["phase"].(string)
+
+	var actions []*data.StructValue
+
+	ruleActions := ruleData["action"]
+	for _, ruleAction := range ruleActions.([]interface{}) {
+
+		ruleAction := ruleAction.(map[string]interface{})
+
+		// Just strings and booleans, we use a helper function for there
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "connection_drop", []string{}, []string{}, model.LBRuleAction_TYPE_LBCONNECTIONDROPACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_redirect", []string{"redirect_status", "redirect_url"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREDIRECTACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_reject", []string{"reply_message", "reply_status"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREJECTACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_header_delete", []string{"header_name"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERDELETEACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_header_rewrite", []string{"header_name", "header_value"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTHEADERREWRITEACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_request_uri_rewrite", []string{"uri", "uri_arguments"}, []string{}, model.LBRuleAction_TYPE_LBHTTPREQUESTURIREWRITEACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_response_header_delete", []string{"header_name"}, []string{}, model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERDELETEACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "http_response_header_rewrite", []string{"header_name", "header_value"}, []string{}, model.LBRuleAction_TYPE_LBHTTPRESPONSEHEADERREWRITEACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "select_pool", []string{"pool_id"}, []string{}, model.LBRuleAction_TYPE_LBSELECTPOOLACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "ssl_mode_selection", []string{"ssl_mode"}, []string{}, model.LBRuleAction_TYPE_LBSSLMODESELECTIONACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_assignment", []string{"variable_name", "variable_value"}, []string{}, model.LBRuleAction_TYPE_LBVARIABLEASSIGNMENTACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_persistence_learn", []string{"persistence_profile_path", "variable_name"}, []string{"variable_hash_enabled"}, model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCELEARNACTION)...)
+		actions = append(actions, getRuleActionOrMethod(ruleAction, "variable_persistence_on", []string{"persistence_profile_path", "variable_name"}, []string{"variable_hash_enabled"}, model.LBRuleAction_TYPE_LBVARIABLEPERSISTENCEONACTION)...)
+
+		// more complicated actions
+		for _, action := range ruleAction["jwt_auth"].([]interface{}) {
+			actionData := action.(map[string]interface{})
+			var fields = make(map[string]data.DataValue)
+			fields["type"] = data.NewStringValue(model.LBRuleAction_TYPE_LBJWTAUTHACTION)
+			if actionData["realm"] != nil {
+				fields["realm"] = data.NewStringValue(actionData["realm"].(string))
+			}
+			if actionData["pass_jwt_to_pool"] != nil {
+				fields["pass_jwt_to_pool"] = data.NewBooleanValue(actionData["pass_jwt_to_pool"].(bool))
+			}
+			// I still haven't fully figured out why key comes as a (*schema.Set) but that's what we want,
+			// a set where no key can be there more than once
+			for _, key := range actionData["key"].(*schema.Set).List() {
+				keyData := key.(map[string]interface{})
+				var keyFields = make(map[string]data.DataValue)
+				if keyData["certificate_path"] != nil && keyData["certificate_path"].(string) != "" {
+					keyFields["certificate_path"] = data.NewStringValue(keyData["certificate_path"].(string))
+					keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTCERTIFICATEKEY)
+				} else if keyData["public_key_content"] != nil && keyData["public_key_content"].(string) != "" {
+					keyFields["public_key_content"] = data.NewStringValue(keyData["public_key_content"].(string))
+					keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTPUBLICKEY)
+				} else if keyData["symmetric_key"] != nil && keyData["symmetric_key"].(string) != "" {
+					// the API only wants the marker id, no actual content parameters
+					keyFields["type"] = data.NewStringValue(model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY)
 				}
-				if actionData["tokens"] != nil {
-					tokenList := data.NewListValue()
-					for _, token := range actionData["tokens"].([]interface{}) {
-						tokenList.Add(data.NewStringValue(token.(string)))
-					}
-					fields["tokens"] = tokenList
+				fields["key"] = data.NewStructValue("", keyFields)
+			}
+			if actionData["tokens"] != nil {
+				tokenList := data.NewListValue()
+				for _, token := range actionData["tokens"].([]interface{}) {
+					tokenList.Add(data.NewStringValue(token.(string)))
 				}
-				elem := data.NewStructValue("", fields)
-				actions = append(actions, elem)
+				fields["tokens"] = tokenList
 			}
+			elem := data.NewStructValue("", fields)
+			actions = append(actions, elem)
 		}
+	}
 
-		var matchConditions []*data.StructValue
-
-		ruleConditions := ruleData["condition"]
-		for _, ruleCondition := range ruleConditions.([]interface{}) {
-
-			ruleCondition := ruleCondition.(map[string]interface{})
-
-			// Just strings and booleans, we use a helper function for there
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_body", []string{"body_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_cookie", []string{"cookie_name", "cookie_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_header", []string{"header_name", "header_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_method", []string{"method"}, []string{}, model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_uri_arguments", []string{"uri_arguments", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_uri", []string{"uri", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_version", []string{"version"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_response_header", []string{"header_name", "header_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "ip_header", []string{"group_path", "source_address"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBIPHEADERCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "ssl_sni", []string{"sni", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBSSLSNICONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "tcp_header", []string{"source_port"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION)...)
-			matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "variable", []string{"match_type", "variable_name", "variable_value"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBVARIABLECONDITION)...)
-
-			// more complicated conditions
-			for _, condition := range ruleCondition["http_ssl"].([]interface{}) {
-				conditionData := condition.(map[string]interface{})
-				var fields = make(map[string]data.DataValue)
-				fields["type"] = data.NewStringValue(model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION)
-				// Not actually a list but that's what the Schems says
-				for _, issuerDn := range conditionData["client_certificate_issuer_dn"].(*schema.Set).List() {
-					issuerDnData := issuerDn.(map[string]interface{})
-					var issuerDnFields = make(map[string]data.DataValue)
-					if issuerDnData["case_sensitive"] != nil {
-						issuerDnFields["case_sensitive"] = data.NewBooleanValue(issuerDnData["case_sensitive"].(bool))
-					}
-					if issuerDnData["issuer_dn"] != nil {
-						issuerDnFields["issuer_dn"] = data.NewStringValue(issuerDnData["issuer_dn"].(string))
-					}
-					if issuerDnData["match_type"] != nil {
-						issuerDnFields["match_type"] = data.NewStringValue(issuerDnData["match_type"].(string))
-					}
-					fields["client_certificate_issuer_dn"] = data.NewStructValue("", issuerDnFields)
+	var matchConditions []*data.StructValue
+
+	ruleConditions := ruleData["condition"]
+	for _, ruleCondition := range ruleConditions.([]interface{}) {
+
+		ruleCondition := ruleCondition.(map[string]interface{})
+
+		// Just strings and booleans, we use a helper function for there
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_body", []string{"body_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTBODYCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_cookie", []string{"cookie_name", "cookie_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTCOOKIECONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_header", []string{"header_name", "header_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTHEADERCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_method", []string{"method"}, []string{}, model.LBRuleCondition_TYPE_LBHTTPREQUESTMETHODCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_uri_arguments", []string{"uri_arguments", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTURIARGUMENTSCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_uri", []string{"uri", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTURICONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_request_version", []string{"version"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBHTTPREQUESTVERSIONCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "http_response_header", []string{"header_name", "header_value", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBHTTPRESPONSEHEADERCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "ip_header", []string{"group_path", "source_address"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBIPHEADERCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "ssl_sni", []string{"sni", "match_type"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBSSLSNICONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "tcp_header", []string{"source_port"}, []string{"inverse"}, model.LBRuleCondition_TYPE_LBTCPHEADERCONDITION)...)
+		matchConditions = append(matchConditions, getRuleActionOrMethod(ruleCondition, "variable", []string{"match_type", "variable_name", "variable_value"}, []string{"case_sensitive", "inverse"}, model.LBRuleCondition_TYPE_LBVARIABLECONDITION)...)
+
+		// more complicated conditions
+		for _, condition := range ruleCondition["http_ssl"].([]interface{}) {
+			conditionData := condition.(map[string]interface{})
+			var fields = make(map[string]data.DataValue)
+			fields["type"] = data.NewStringValue(model.LBRuleCondition_TYPE_LBHTTPSSLCONDITION)
+			// Not actually a list but that's what the Schems says
+			for _, issuerDn := range conditionData["client_certificate_issuer_dn"].(*schema.Set).List() {
+				issuerDnData := issuerDn.(map[string]interface{})
+				var issuerDnFields = make(map[string]data.DataValue)
+				if issuerDnData["case_sensitive"] != nil {
+					issuerDnFields["case_sensitive"] = data.NewBooleanValue(issuerDnData["case_sensitive"].(bool))
 				}
-				// Not actually a list but that's what the Schems says
-				for _, subjectDn := range conditionData["client_certificate_subject_dn"].(*schema.Set).List() {
-					subjectDnData := subjectDn.(map[string]interface{})
-					var subjectDnFields = make(map[string]data.DataValue)
-					if subjectDnData["case_sensitive"] != nil {
-						subjectDnFields["case_sensitive"] = data.NewBooleanValue(subjectDnData["case_sensitive"].(bool))
-					}
-					if subjectDnData["subject_dn"] != nil {
-						subjectDnFields["subject_dn"] = data.NewStringValue(subjectDnData["subject_dn"].(string))
-					}
-					if subjectDnData["match_type"] != nil {
-						subjectDnFields["match_type"] = data.NewStringValue(subjectDnData["match_type"].(string))
-					}
-					fields["client_certificate_subject_dn"] = data.NewStructValue("", subjectDnFields)
+				if issuerDnData["issuer_dn"] != nil {
+					issuerDnFields["issuer_dn"] = data.NewStringValue(issuerDnData["issuer_dn"].(string))
 				}
-				if conditionData["client_supported_ssl_ciphers"] != nil {
-					cipherList := data.NewListValue()
-					for _, cipher := range conditionData["client_supported_ssl_ciphers"].([]interface{}) {
-						cipherList.Add(data.NewStringValue(cipher.(string)))
-					}
-					fields["client_supported_ssl_ciphers"] = cipherList
+				if issuerDnData["match_type"] != nil {
+					issuerDnFields["match_type"] = data.NewStringValue(issuerDnData["match_type"].(string))
 				}
-				if conditionData["inverse"] != nil {
-					fields["inverse"] = data.NewBooleanValue(conditionData["inverse"].(bool))
+				fields["client_certificate_issuer_dn"] = data.NewStructValue("", issuerDnFields)
+			}
+			// Not actually a list but that's what the Schems says
+			for _, subjectDn := range conditionData["client_certificate_subject_dn"].(*schema.Set).List() {
+				subjectDnData := subjectDn.(map[string]interface{})
+				var subjectDnFields = make(map[string]data.DataValue)
+				if subjectDnData["case_sensitive"] != nil {
+					subjectDnFields["case_sensitive"] = data.NewBooleanValue(subjectDnData["case_sensitive"].(bool))
 				}
-				if conditionData["session_reused"] != nil {
-					fields["session_reused"] = data.NewStringValue(conditionData["session_reused"].(string))
+				if subjectDnData["subject_dn"] != nil {
+					subjectDnFields["subject_dn"] = data.NewStringValue(subjectDnData["subject_dn"].(string))
 				}
-				if conditionData["used_protocol"] != nil {
-					fields["used_protocol"] = data.NewStringValue(conditionData["used_protocol"].(string))
+				if subjectDnData["match_type"] != nil {
+					subjectDnFields["match_type"] = data.NewStringValue(subjectDnData["match_type"].(string))
 				}
-				if conditionData["used_ssl_cipher"] != nil {
-					fields["used_ssl_cipher"] = data.NewStringValue(conditionData["used_ssl_cipher"].(string))
+				fields["client_certificate_subject_dn"] = data.NewStructValue("", subjectDnFields)
+			}
+			if conditionData["client_supported_ssl_ciphers"] != nil {
+				cipherList := data.NewListValue()
+				for _, cipher := range conditionData["client_supported_ssl_ciphers"].([]interface{}) {
+					cipherList.Add(data.NewStringValue(cipher.(string)))
 				}
-				elem := data.NewStructValue("", fields)
-				matchConditions = append(matchConditions, elem)
+				fields["client_supported_ssl_ciphers"] = cipherList
+			}
+			if conditionData["inverse"] != nil {
+				fields["inverse"] = data.NewBooleanValue(conditionData["inverse"].(bool))
 			}
+			if conditionData["session_reused"] != nil {
+				fields["session_reused"] = data.NewStringValue(conditionData["session_reused"].(string))
+			}
+			if conditionData["used_protocol"] != nil {
+				fields["used_protocol"] = data.NewStringValue(conditionData["used_protocol"].(string))
+			}
+			if conditionData["used_ssl_cipher"] != nil {
+				fields["used_ssl_cipher"] = data.NewStringValue(conditionData["used_ssl_cipher"].(string))
+			}
+			elem := data.NewStructValue("", fields)
+			matchConditions = append(matchConditions, elem)
 		}
+	}
 
-		elem := model.LBRule{
-			DisplayName:     &displayName,
-			MatchStrategy:   &matchStrategy,
-			Phase:           &phase,
-			Actions:         actions,
-			MatchConditions: matchConditions,
-		}
-		ruleList = append(ruleList, elem)
+	return model.LBRule{
+		DisplayName:     &displayName,
+		MatchStrategy:   &matchStrategy,
+		Phase:           &phase,
+		Actions:         actions,
+		MatchConditions: matchConditions,
 	}
-	return ruleList
 }
 
 func policyLBVirtualServerVersionDependantSet(d *schema.ResourceData, obj *model.LBVirtualServer) {
@@ -0,0 +1,252 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Number of attempts to apply rule change when virtual server is
+// modified concurrently, e.g. by other rule resources
+var policyLBVirtualServerRuleMaxAttempts = 5
+
+var policyLBVirtualServerRuleAttributes = []string{"display_name", "match_strategy", "phase", "action", "condition"}
+
+func resourceNsxtPolicyLBVirtualServerRule() *schema.Resource {
+	ruleSchema := getPolicyLbRuleBindingSchema().Schema
+	// All actions and conditions of the rule are specified in single block
+	ruleSchema["action"].MaxItems = 1
+	ruleSchema["condition"].MaxItems = 1
+	// Rules have no identifier in NSX, hence display name identifies the rule
+	// within the virtual server
+	ruleSchema["display_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Display name of the rule, unique within the virtual server",
+		Required:    true,
+		ForceNew:    true,
+	}
+	ruleSchema["virtual_server_path"] = getPolicyPathSchema(true, true, "Policy path of the virtual server this rule belongs to")
+	ruleSchema["sequence_number"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Position of the rule in the virtual server rule list, starting with 1. If not specified, rule is appended to the list",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	ruleSchema["revision"] = getRevisionSchema()
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyLBVirtualServerRuleCreate,
+		Read:   resourceNsxtPolicyLBVirtualServerRuleRead,
+		Update: resourceNsxtPolicyLBVirtualServerRuleUpdate,
+		Delete: resourceNsxtPolicyLBVirtualServerRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyLBVirtualServerRuleImport,
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+func getPolicyLBVirtualServerRuleIndex(rules []model.LBRule, displayName string) int {
+	for i, rule := range rules {
+		if rule.DisplayName != nil && *rule.DisplayName == displayName {
+			return i
+		}
+	}
+	return -1
+}
+
+func getPolicyLBVirtualServerRuleFromSchema(d *schema.ResourceData) model.LBRule {
+	ruleData := make(map[string]interface{})
+	for _, attr := range policyLBVirtualServerRuleAttributes {
+		ruleData[attr] = d.Get(attr)
+	}
+	return getPolicyLbRuleFromSchemaMap(ruleData)
+}
+
+// Apply rule change to virtual server using its revision, so that changes made to the
+// virtual server in the meantime are not overridden. The change is retried on revision
+// mismatch.
+func policyLBVirtualServerRulesReadModifyWrite(connector client.Connector, virtualServerID string, modify func([]model.LBRule) ([]model.LBRule, error)) (*model.LBVirtualServer, error) {
+	client := infra.NewLbVirtualServersClient(connector)
+	var err error
+	for attempt := 1; attempt <= policyLBVirtualServerRuleMaxAttempts; attempt++ {
+		var obj model.LBVirtualServer
+		obj, err = client.Get(virtualServerID)
+		if err != nil {
+			return nil, err
+		}
+
+		obj.Rules, err = modify(obj.Rules)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, err = client.Update(virtualServerID, obj)
+		if err == nil {
+			return &obj, nil
+		}
+		if !isConcurrentChangeError(err) {
+			return nil, err
+		}
+		log.Printf("[INFO] LBVirtualServer %s was modified concurrently, retrying rule change (attempt %d)", virtualServerID, attempt)
+	}
+
+	return nil, err
+}
+
+func insertPolicyLBVirtualServerRule(rules []model.LBRule, rule model.LBRule, sequenceNumber int) []model.LBRule {
+	position := len(rules)
+	if sequenceNumber > 0 && sequenceNumber-1 < position {
+		position = sequenceNumber - 1
+	}
+
+	result := make([]model.LBRule, 0, len(rules)+1)
+	result = append(result, rules[:position]...)
+	result = append(result, rule)
+	return append(result, rules[position:]...)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	virtualServerPath := d.Get("virtual_server_path").(string)
+	virtualServerID := getPolicyIDFromPath(virtualServerPath)
+	displayName := d.Get("display_name").(string)
+	id := fmt.Sprintf("%s/%s", virtualServerID, displayName)
+
+	rule := getPolicyLBVirtualServerRuleFromSchema(d)
+	sequenceNumber := d.Get("sequence_number").(int)
+
+	log.Printf("[INFO] Creating LBVirtualServer rule %s", id)
+	_, err := policyLBVirtualServerRulesReadModifyWrite(connector, virtualServerID, func(rules []model.LBRule) ([]model.LBRule, error) {
+		if getPolicyLBVirtualServerRuleIndex(rules, displayName) >= 0 {
+			return nil, fmt.Errorf("Rule %s already exists on virtual server %s", displayName, virtualServerPath)
+		}
+		return insertPolicyLBVirtualServerRule(rules, rule, sequenceNumber), nil
+	})
+	if err != nil {
+		return handleCreateError("LBVirtualServer Rule", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyLBVirtualServerRuleRead(d, m)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBVirtualServer Rule ID")
+	}
+
+	virtualServerID := getPolicyIDFromPath(d.Get("virtual_server_path").(string))
+	displayName := d.Get("display_name").(string)
+
+	client := infra.NewLbVirtualServersClient(connector)
+	obj, err := client.Get(virtualServerID)
+	if err != nil {
+		return handleReadError(d, "LBVirtualServer Rule", id, err)
+	}
+
+	index := getPolicyLBVirtualServerRuleIndex(obj.Rules, displayName)
+	if index < 0 {
+		log.Printf("[DEBUG] Rule %s not found on LBVirtualServer %s", displayName, virtualServerID)
+		d.SetId("")
+		return nil
+	}
+
+	ruleElem := getPolicyLbRuleSchemaMap(obj.Rules[index])
+	for _, attr := range policyLBVirtualServerRuleAttributes {
+		d.Set(attr, ruleElem[attr])
+	}
+	d.Set("sequence_number", index+1)
+	d.Set("revision", obj.Revision)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBVirtualServer Rule ID")
+	}
+
+	virtualServerPath := d.Get("virtual_server_path").(string)
+	virtualServerID := getPolicyIDFromPath(virtualServerPath)
+	displayName := d.Get("display_name").(string)
+
+	rule := getPolicyLBVirtualServerRuleFromSchema(d)
+	sequenceNumber := d.Get("sequence_number").(int)
+
+	log.Printf("[INFO] Updating LBVirtualServer rule %s", id)
+	_, err := policyLBVirtualServerRulesReadModifyWrite(connector, virtualServerID, func(rules []model.LBRule) ([]model.LBRule, error) {
+		index := getPolicyLBVirtualServerRuleIndex(rules, displayName)
+		if index < 0 {
+			return nil, fmt.Errorf("Rule %s not found on virtual server %s", displayName, virtualServerPath)
+		}
+		rules = append(rules[:index:index], rules[index+1:]...)
+		return insertPolicyLBVirtualServerRule(rules, rule, sequenceNumber), nil
+	})
+	if err != nil {
+		return handleUpdateError("LBVirtualServer Rule", id, err)
+	}
+
+	return resourceNsxtPolicyLBVirtualServerRuleRead(d, m)
+}
+
+func resourceNsxtPolicyLBVirtualServerRuleDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBVirtualServer Rule ID")
+	}
+
+	virtualServerID := getPolicyIDFromPath(d.Get("virtual_server_path").(string))
+	displayName := d.Get("display_name").(string)
+
+	log.Printf("[INFO] Deleting LBVirtualServer rule %s", id)
+	_, err := policyLBVirtualServerRulesReadModifyWrite(connector, virtualServerID, func(rules []model.LBRule) ([]model.LBRule, error) {
+		index := getPolicyLBVirtualServerRuleIndex(rules, displayName)
+		if index < 0 {
+			return rules, nil
+		}
+		return append(rules[:index:index], rules[index+1:]...), nil
+	})
+	if err != nil {
+		return handleDeleteError("LBVirtualServer Rule", id, err)
+	}
+
+	return nil
+}
+
+// Import ID is expected in form <virtual server path>/rules/<rule display name>
+func resourceNsxtPolicyLBVirtualServerRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	idx := strings.LastIndex(importID, "/rules/")
+	if idx <= 0 || !isPolicyPath(importID[:idx]) {
+		return nil, fmt.Errorf("Invalid import ID %s, expected <virtual server path>/rules/<rule name>", importID)
+	}
+
+	virtualServerPath := importID[:idx]
+	displayName := importID[idx+len("/rules/"):]
+	d.Set("virtual_server_path", virtualServerPath)
+	d.Set("display_name", displayName)
+	d.SetId(fmt.Sprintf("%s/%s", getPolicyIDFromPath(virtualServerPath), displayName))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,183 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+)
+
+func TestAccResourceNsxtPolicyLBVirtualServerRule_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_virtual_server_rule.test"
+	secondResourceName := "nsxt_policy_lb_virtual_server_rule.second"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerRuleExists(testResourceName),
+					testAccNsxtPolicyLBVirtualServerRuleExists(secondResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", "header_delete"),
+					resource.TestCheckResourceAttr(testResourceName, "phase", "HTTP_REQUEST_REWRITE"),
+					resource.TestCheckResourceAttr(testResourceName, "match_strategy", "ALL"),
+					resource.TestCheckResourceAttr(testResourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "action.0.http_request_header_delete.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(secondResourceName, "display_name", "connection_drop"),
+					resource.TestCheckResourceAttr(secondResourceName, "sequence_number", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerRuleExists(testResourceName),
+					testAccNsxtPolicyLBVirtualServerRuleExists(secondResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "action.0.http_request_header_delete.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "condition.0.ip_header.0.source_address", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(secondResourceName, "sequence_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBVirtualServerRule_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_virtual_server_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBVirtualServerRuleTemplate(name, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicyLBVirtualServerRuleImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_lb_virtual_server_rule.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy LB Virtual Server Rule resource not found in resources")
+	}
+	virtualServerPath := rs.Primary.Attributes["virtual_server_path"]
+	if virtualServerPath == "" {
+		return "", fmt.Errorf("NSX Policy LB Virtual Server Rule virtual_server_path not set in resources")
+	}
+	displayName := rs.Primary.Attributes["display_name"]
+	return fmt.Sprintf("%s/rules/%s", virtualServerPath, displayName), nil
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		client := infra.NewLbVirtualServersClient(connector)
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LB Virtual Server Rule resource %s not found in resources", resourceName)
+		}
+
+		virtualServerID := getPolicyIDFromPath(rs.Primary.Attributes["virtual_server_path"])
+		displayName := rs.Primary.Attributes["display_name"]
+		obj, err := client.Get(virtualServerID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving policy LB Virtual Server ID %s. Error: %v", virtualServerID, err)
+		}
+
+		if getPolicyLBVirtualServerRuleIndex(obj.Rules, displayName) < 0 {
+			return fmt.Errorf("Policy LB Virtual Server Rule %s does not exist on %s", displayName, virtualServerID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBVirtualServerRuleTemplate(name string, reorder bool) string {
+	firstSequence := 1
+	secondSequence := 2
+	extraCondition := ""
+	if reorder {
+		firstSequence = 2
+		secondSequence = 1
+		extraCondition = `
+    ip_header {
+      source_address = "10.10.0.0/16"
+    }`
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_app_profile" "default_http"{
+  type         = "HTTP"
+  display_name = "default-http-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_http.path
+  ip_address               = "1.1.1.1"
+  ports                    = ["80"]
+}
+
+resource "nsxt_policy_lb_virtual_server_rule" "test" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+  display_name        = "header_delete"
+  sequence_number     = %d
+  match_strategy      = "ALL"
+  phase               = "HTTP_REQUEST_REWRITE"
+
+  action {
+    http_request_header_delete {
+      header_name = "clever"
+    }
+    http_request_header_delete {
+      header_name = "other"
+    }
+  }
+
+  condition {
+    http_request_method {
+      method = "POST"
+    }%s
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server_rule" "second" {
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+  display_name        = "connection_drop"
+  sequence_number     = %d
+
+  action {
+    connection_drop {}
+  }
+
+  depends_on = [nsxt_policy_lb_virtual_server_rule.test]
+}`, name, firstSequence, extraCondition, secondSequence)
+}
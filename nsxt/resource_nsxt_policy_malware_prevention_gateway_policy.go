@@ -0,0 +1,222 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMalwarePreventionGatewayPolicyCreate,
+		Read:   resourceNsxtPolicyMalwarePreventionGatewayPolicyRead,
+		Update: resourceNsxtPolicyMalwarePreventionGatewayPolicyUpdate,
+		Delete: resourceNsxtPolicyMalwarePreventionGatewayPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: getPolicyMalwarePreventionGatewayPolicySchema(),
+	}
+}
+
+func getPolicyMalwarePreventionGatewayPolicySchema() map[string]*schema.Schema {
+	secPolicy := getPolicySecurityPolicySchema(true, false, true)
+	// Gateway rules require scope to be set
+	secPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, true, true)
+	return secPolicy
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsInDomain(id string, domainName string, connector client.Connector) (bool, error) {
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Malware Prevention Gateway Policy", err)
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsPartial(domainName string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func createChildDomainWithIdsGatewayPolicy(domain string, policyID string, policy model.IdsGatewayPolicy) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childPolicy := model.ChildIdsGatewayPolicy{
+		Id:               &policyID,
+		ResourceType:     "ChildIdsGatewayPolicy",
+		IdsGatewayPolicy: &policy,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildIdsGatewayPolicyBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	var domainChildren []*data.StructValue
+	domainChildren = append(domainChildren, dataValue.(*data.StructValue))
+
+	targetType := "Domain"
+	childDomain := model.ChildResourceReference{
+		Id:           &domain,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &targetType,
+		Children:     domainChildren,
+	}
+
+	dataValue, errors = converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func updateIdsGatewayPolicy(id string, d *schema.ResourceData, m interface{}) error {
+
+	domain := d.Get("domain").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	stateful := d.Get("stateful").(bool)
+	resourceType := "IdsGatewayPolicy"
+
+	obj := model.IdsGatewayPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		Stateful:       &stateful,
+		ResourceType:   &resourceType,
+	}
+
+	childRules, err := getPolicyIdsChildRules(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG]: Updating IDS gateway policy %s with %d child rules", id, len(childRules))
+	if len(childRules) > 0 {
+		obj.Children = childRules
+	}
+
+	childDomain, err := createChildDomainWithIdsGatewayPolicy(domain, id, obj)
+	if err != nil {
+		return fmt.Errorf("Failed to create H-API for Ids Gateway Policy: %s", err)
+	}
+
+	var infraChildren []*data.StructValue
+	infraChildren = append(infraChildren, childDomain)
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     infraChildren,
+		ResourceType: &infraType,
+	}
+
+	return policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsPartial(d.Get("domain").(string)))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Malware Prevention Gateway Policy with ID %s", id)
+	err = updateIdsGatewayPolicy(id, d, m)
+
+	if err != nil {
+		return handleCreateError("Malware Prevention Gateway Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMalwarePreventionGatewayPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	domainName := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Gateway Policy id")
+	}
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	obj, err := client.Get(domainName, id)
+	if err != nil {
+		return handleReadError(d, "Malware Prevention Gateway Policy", id, err)
+	}
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("stateful", obj.Stateful)
+	d.Set("revision", obj.Revision)
+	return setPolicyIdsRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Gateway Policy id")
+	}
+
+	log.Printf("[INFO] Updating Malware Prevention Gateway Policy with ID %s", id)
+	err := updateIdsGatewayPolicy(id, d, m)
+
+	if err != nil {
+		return handleUpdateError("Malware Prevention Gateway Policy", id, err)
+	}
+
+	return resourceNsxtPolicyMalwarePreventionGatewayPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Gateway Policy id")
+	}
+
+	connector := getPolicyConnector(m)
+
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	err := client.Delete(d.Get("domain").(string), id)
+
+	if err != nil {
+		return handleDeleteError("Malware Prevention Gateway Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyMalwarePreventionGatewayPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_malware_prevention_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionGatewayPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionGatewayPolicyCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMalwarePreventionGatewayPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DETECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.ids_profiles.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMalwarePreventionGatewayPolicyUpdate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMalwarePreventionGatewayPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DETECT_PREVENT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.disabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMalwarePreventionGatewayPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_malware_prevention_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionGatewayPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionGatewayPolicyCreate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMalwarePreventionGatewayPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsInDomain(resourceID, defaultDomain, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Error while retrieving policy resource ID %s", resourceID)
+		}
+		return nil
+	}
+}
+
+func testAccNsxtPolicyMalwarePreventionGatewayPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_malware_prevention_gateway_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyMalwarePreventionGatewayPolicyExistsInDomain(resourceID, defaultDomain, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy resource %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyMalwarePreventionGatewayPolicyDeps() string {
+	return `
+resource "nsxt_policy_malware_prevention_service_profile" "test" {
+  display_name = "tf-malware-profile"
+  file_types   = ["EXECUTABLE", "DOCUMENT"]
+}
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "tf-malware-t1"
+}
+
+resource "nsxt_policy_group" "group1" {
+  display_name = "terraform testacc 1"
+}
+
+resource "nsxt_policy_group" "group2" {
+  display_name = "terraform testacc 2"
+}`
+}
+
+func testAccNsxtPolicyMalwarePreventionGatewayPolicyCreate(name string) string {
+	return testAccNsxtPolicyMalwarePreventionGatewayPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_malware_prevention_gateway_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+    scope         = [nsxt_policy_tier1_gateway.test.path]
+    ids_profiles  = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyMalwarePreventionGatewayPolicyUpdate(name string) string {
+	return testAccNsxtPolicyMalwarePreventionGatewayPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_malware_prevention_gateway_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+    scope         = [nsxt_policy_tier1_gateway.test.path]
+    action        = "DETECT_PREVENT"
+    ids_profiles  = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+
+  rule {
+    display_name       = "rule2"
+    destination_groups = [nsxt_policy_group.group2.path]
+    disabled           = true
+    scope              = [nsxt_policy_tier1_gateway.test.path]
+    ids_profiles       = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+}`, name)
+}
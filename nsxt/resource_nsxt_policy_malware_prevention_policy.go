@@ -0,0 +1,110 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/domains"
+)
+
+// Distributed Malware Prevention rules are IDS rules that refer to Malware Prevention
+// profiles, hence rule schema and H-API handling is shared with Intrusion Service Policy
+func resourceNsxtPolicyMalwarePreventionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMalwarePreventionPolicyCreate,
+		Read:   resourceNsxtPolicyMalwarePreventionPolicyRead,
+		Update: resourceNsxtPolicyMalwarePreventionPolicyUpdate,
+		Delete: resourceNsxtPolicyMalwarePreventionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: getPolicySecurityPolicySchema(true, true, true),
+	}
+}
+
+func resourceNsxtPolicyMalwarePreventionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIntrusionServicePolicyExistsPartial(getSessionContext(d, m), d.Get("domain").(string)))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Malware Prevention Policy with ID %s", id)
+	err = updateIdsSecurityPolicy(id, d, m)
+
+	if err != nil {
+		return handleCreateError("Malware Prevention Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMalwarePreventionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	domainName := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Policy id")
+	}
+	client := domains.NewIntrusionServicePoliciesClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(domainName, id)
+	if err != nil {
+		return handleReadError(d, "Malware Prevention Policy", id, err)
+	}
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("stateful", obj.Stateful)
+	d.Set("revision", obj.Revision)
+	return setPolicyIdsRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyMalwarePreventionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Policy id")
+	}
+
+	log.Printf("[INFO] Updating Malware Prevention Policy with ID %s", id)
+	err := updateIdsSecurityPolicy(id, d, m)
+
+	if err != nil {
+		return handleUpdateError("Malware Prevention Policy", id, err)
+	}
+
+	return resourceNsxtPolicyMalwarePreventionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Policy id")
+	}
+
+	connector := getPolicyConnector(m)
+
+	client := domains.NewIntrusionServicePoliciesClient(getSessionContext(d, m), connector)
+	err := client.Delete(d.Get("domain").(string), id)
+
+	if err != nil {
+		return handleDeleteError("Malware Prevention Policy", id, err)
+	}
+
+	return nil
+}
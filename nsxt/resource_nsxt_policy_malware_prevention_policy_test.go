@@ -0,0 +1,170 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyMalwarePreventionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_malware_prevention_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionPolicyCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIntrusionServicePolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DETECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.ids_profiles.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyMalwarePreventionPolicyUpdate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIntrusionServicePolicyExists(testResourceName, defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DETECT_PREVENT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.disabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMalwarePreventionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_malware_prevention_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMalwarePreventionPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMalwarePreventionPolicyCreate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyMalwarePreventionPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_malware_prevention_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIntrusionServicePolicyExistsInDomain(testAccGetSessionContext(), resourceID, defaultDomain, connector)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Policy resource %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyMalwarePreventionPolicyDeps() string {
+	return `
+resource "nsxt_policy_malware_prevention_service_profile" "test" {
+  display_name = "tf-malware-profile"
+  file_types   = ["EXECUTABLE", "DOCUMENT"]
+}
+
+resource "nsxt_policy_group" "group1" {
+  display_name = "terraform testacc 1"
+}
+
+resource "nsxt_policy_group" "group2" {
+  display_name = "terraform testacc 2"
+}`
+}
+
+func testAccNsxtPolicyMalwarePreventionPolicyCreate(name string) string {
+	return testAccNsxtPolicyMalwarePreventionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_malware_prevention_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+    ids_profiles  = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyMalwarePreventionPolicyUpdate(name string) string {
+	return testAccNsxtPolicyMalwarePreventionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_malware_prevention_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+    action        = "DETECT_PREVENT"
+    ids_profiles  = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+
+  rule {
+    display_name       = "rule2"
+    destination_groups = [nsxt_policy_group.group2.path]
+    disabled           = true
+    ids_profiles       = [nsxt_policy_malware_prevention_service_profile.test.path]
+  }
+}`, name)
+}
@@ -0,0 +1,182 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/malware_prevention_service"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var malwarePreventionProfileFileTypeValues = []string{
+	model.MalwarePreventionProfile_FILE_TYPE_DOCUMENT,
+	model.MalwarePreventionProfile_FILE_TYPE_EXECUTABLE,
+	model.MalwarePreventionProfile_FILE_TYPE_MEDIA,
+	model.MalwarePreventionProfile_FILE_TYPE_ARCHIVE,
+	model.MalwarePreventionProfile_FILE_TYPE_DATA,
+	model.MalwarePreventionProfile_FILE_TYPE_SCRIPT,
+	model.MalwarePreventionProfile_FILE_TYPE_OTHER,
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyMalwarePreventionServiceProfileCreate,
+		Read:   resourceNsxtPolicyMalwarePreventionServiceProfileRead,
+		Update: resourceNsxtPolicyMalwarePreventionServiceProfileUpdate,
+		Delete: resourceNsxtPolicyMalwarePreventionServiceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"file_types": {
+				Type:        schema.TypeSet,
+				Description: "File categories to be analyzed by Malware Prevention",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(malwarePreventionProfileFileTypeValues, false),
+				},
+				Optional: true,
+				Computed: true,
+			},
+			"cloud_analysis": {
+				Type:        schema.TypeBool,
+				Description: "Submit unknown files for cloud based sandboxing analysis in addition to local signature based detection",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := malware_prevention_service.NewProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Malware Prevention Service Profile", err)
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	fileTypes := getStringListFromSchemaSet(d, "file_types")
+	detectionType := model.MalwarePreventionProfile_DETECTION_TYPE_BASED
+	if d.Get("cloud_analysis").(bool) {
+		detectionType = model.MalwarePreventionProfile_DETECTION_TYPE_AND_SANDBOXING_BASED
+	}
+
+	obj := model.MalwarePreventionProfile{
+		DisplayName:   &displayName,
+		Description:   &description,
+		Tags:          tags,
+		DetectionType: &detectionType,
+	}
+
+	if len(fileTypes) > 0 {
+		obj.FileType = fileTypes
+	}
+
+	log.Printf("[INFO] Patching Malware Prevention Service Profile with ID %s", id)
+	client := malware_prevention_service.NewProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyMalwarePreventionServiceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyMalwarePreventionServiceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Malware Prevention Service Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyMalwarePreventionServiceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Service Profile ID")
+	}
+
+	client := malware_prevention_service.NewProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Malware Prevention Service Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("file_types", obj.FileType)
+	cloudAnalysis := obj.DetectionType != nil && *obj.DetectionType == model.MalwarePreventionProfile_DETECTION_TYPE_AND_SANDBOXING_BASED
+	d.Set("cloud_analysis", cloudAnalysis)
+
+	return nil
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Service Profile ID")
+	}
+
+	err := resourceNsxtPolicyMalwarePreventionServiceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Malware Prevention Service Profile", id, err)
+	}
+
+	return resourceNsxtPolicyMalwarePreventionServiceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyMalwarePreventionServiceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Malware Prevention Service Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := malware_prevention_service.NewProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Malware Prevention Service Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyPimProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyPimProfileCreate,
+		Read:   resourceNsxtPolicyPimProfileRead,
+		Update: resourceNsxtPolicyPimProfileUpdate,
+		Delete: resourceNsxtPolicyPimProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bsm_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable bootstrap messaging",
+				Optional:    true,
+				Default:     true,
+			},
+			"rp_address": {
+				Type:         schema.TypeString,
+				Description:  "Static rendezvous point address for all multicast groups",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"static_rp": {
+				Type:        schema.TypeList,
+				Description: "Static rendezvous point address with associated multicast groups",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rp_address": {
+							Type:         schema.TypeString,
+							Description:  "Static rendezvous point address",
+							Required:     true,
+							ValidateFunc: validateSingleIP(),
+						},
+						"multicast_ranges": {
+							Type:        schema.TypeList,
+							Description: "Multicast group ranges served by this rendezvous point",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIPCidr(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyPimProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving PIM Profile", err)
+}
+
+func getPolicyPimProfileStaticRpFromSchema(d *schema.ResourceData) []model.RpAddressMulticastRanges {
+	var result []model.RpAddressMulticastRanges
+	for _, item := range d.Get("static_rp").([]interface{}) {
+		data := item.(map[string]interface{})
+		rpAddress := data["rp_address"].(string)
+		result = append(result, model.RpAddressMulticastRanges{
+			RpAddress:       &rpAddress,
+			MulticastRanges: interfaceListToStringList(data["multicast_ranges"].([]interface{})),
+		})
+	}
+
+	return result
+}
+
+func setPolicyPimProfileStaticRpInSchema(d *schema.ResourceData, ranges []model.RpAddressMulticastRanges) error {
+	var result []map[string]interface{}
+	for _, item := range ranges {
+		elem := make(map[string]interface{})
+		elem["rp_address"] = item.RpAddress
+		elem["multicast_ranges"] = item.MulticastRanges
+		result = append(result, elem)
+	}
+
+	return d.Set("static_rp", result)
+}
+
+func resourceNsxtPolicyPimProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bsmEnabled := d.Get("bsm_enabled").(bool)
+	rpAddress := d.Get("rp_address").(string)
+
+	obj := model.PolicyPimProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		BsmEnabled:  &bsmEnabled,
+	}
+
+	if nsxVersionHigherOrEqual("4.0.0") {
+		obj.RpAddressMulticastRanges = getPolicyPimProfileStaticRpFromSchema(d)
+	}
+
+	if len(rpAddress) > 0 {
+		obj.RpAddress = &rpAddress
+	}
+
+	log.Printf("[INFO] Patching PIM Profile with ID %s", id)
+	client := infra.NewPimProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyPimProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyPimProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("PIM Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PIM Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("bsm_enabled", obj.BsmEnabled)
+	d.Set("rp_address", obj.RpAddress)
+
+	return setPolicyPimProfileStaticRpInSchema(d, obj.RpAddressMulticastRanges)
+}
+
+func resourceNsxtPolicyPimProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	err := resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("PIM Profile", id, err)
+	}
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("PIM Profile", id, err)
+	}
+
+	return nil
+}
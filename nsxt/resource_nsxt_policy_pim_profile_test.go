@@ -0,0 +1,169 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyPimProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_pim_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyPimProfileCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyPimProfileTemplate(name, "true", "10.10.10.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.0.rp_address", "10.10.10.1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.0.multicast_ranges.#", "2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyPimProfileTemplate(updatedName, "false", "10.10.20.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.0.rp_address", "10.10.20.1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyPimProfileMinimalistic(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "static_rp.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyPimProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_pim_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyPimProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyPimProfileMinimalistic(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyPimProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy PIM Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy PIM Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyPimProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy PIM Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyPimProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_pim_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyPimProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy PIM Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyPimProfileTemplate(name string, bsmEnabled string, rpAddress string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  bsm_enabled  = %s
+
+  static_rp {
+    rp_address       = "%s"
+    multicast_ranges = ["239.1.1.0/24", "239.1.2.0/24"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, bsmEnabled, rpAddress)
+}
+
+func testAccNsxtPolicyPimProfileMinimalistic(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}`, name)
+}
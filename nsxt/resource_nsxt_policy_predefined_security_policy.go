@@ -406,6 +406,20 @@ func resourceNsxtPolicyPredefinedSecurityPolicyDelete(d *schema.ResourceData, m
 }
 
 func securityPolicyInfraPatch(context utl.SessionContext, policy model.SecurityPolicy, domain string, m interface{}) error {
+	if context.ClientType == utl.VPC {
+		converter := bindings.NewTypeConverter()
+		childPolicy := model.ChildSecurityPolicy{
+			ResourceType:   "ChildSecurityPolicy",
+			SecurityPolicy: &policy,
+		}
+		dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+		if len(errors) > 0 {
+			return fmt.Errorf("Failed to create H-API for VPC Security Policy: %s", errors[0])
+		}
+
+		return policyVPCInfraPatch(context, []*data.StructValue{dataValue.(*data.StructValue)}, getPolicyConnector(m), false)
+	}
+
 	childDomain, err := createChildDomainWithSecurityPolicy(domain, *policy.Id, policy)
 	if err != nil {
 		return fmt.Errorf("Failed to create H-API for Predefined Security Policy: %s", err)
@@ -0,0 +1,454 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var redirectionRuleActionValues = []string{
+	model.RedirectionRule_ACTION_REDIRECT,
+	model.RedirectionRule_ACTION_DO_NOT_REDIRECT,
+}
+
+func resourceNsxtPolicyRedirectionPolicy() *schema.Resource {
+	policySchema := getPolicySecurityPolicySchema(false, false, false)
+	delete(policySchema, "category")
+	delete(policySchema, "tcp_strict")
+	policySchema["north_south"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether this policy redirects north-south traffic on gateways, rather than east-west traffic",
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+	}
+	policySchema["redirect_to"] = getPolicyPathSchema(true, false, "Path of service chain, service instance or virtual endpoint to redirect traffic to")
+	policySchema["rule"] = getRedirectionRulesSchema()
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyRedirectionPolicyCreate,
+		Read:   resourceNsxtPolicyRedirectionPolicyRead,
+		Update: resourceNsxtPolicyRedirectionPolicyUpdate,
+		Delete: resourceNsxtPolicyRedirectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: policySchema,
+	}
+}
+
+func getRedirectionRulesSchema() *schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRuleSchema(false, false, true, false)
+	ruleSchema["action"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Action",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(redirectionRuleActionValues, false),
+		Default:      model.RedirectionRule_ACTION_REDIRECT,
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of rules in the section",
+		Optional:    true,
+		MaxItems:    1000,
+		Elem: &schema.Resource{
+			Schema: ruleSchema,
+		},
+	}
+}
+
+func resourceNsxtPolicyRedirectionPolicyExistsInDomain(id string, domainName string, connector client.Connector) (bool, error) {
+	client := domains.NewRedirectionPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Redirection Policy", err)
+}
+
+func resourceNsxtPolicyRedirectionPolicyExistsPartial(domainName string) func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyRedirectionPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func setPolicyRedirectionRulesInSchema(d *schema.ResourceData, rules []model.RedirectionRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["path"] = rule.Path
+		elem["notes"] = rule.Notes
+		elem["logged"] = rule.Logged
+		elem["log_label"] = rule.Tag
+		elem["action"] = rule.Action
+		elem["destinations_excluded"] = rule.DestinationsExcluded
+		elem["sources_excluded"] = rule.SourcesExcluded
+		if rule.IpProtocol == nil {
+			elem["ip_version"] = "NONE"
+		} else {
+			elem["ip_version"] = rule.IpProtocol
+		}
+		elem["direction"] = rule.Direction
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		setPathListInMap(elem, "source_groups", rule.SourceGroups)
+		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+		setPathListInMap(elem, "profiles", rule.Profiles)
+		setPathListInMap(elem, "services", rule.Services)
+		setPathListInMap(elem, "scope", rule.Scope)
+		elem["sequence_number"] = rule.SequenceNumber
+		elem["nsx_id"] = rule.Id
+		elem["rule_id"] = rule.RuleId
+
+		var tagList []map[string]string
+		for _, tag := range rule.Tags {
+			tags := make(map[string]string)
+			tags["scope"] = *tag.Scope
+			tags["tag"] = *tag.Tag
+			tagList = append(tagList, tags)
+		}
+		elem["tag"] = tagList
+
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyRedirectionRulesFromSchema(d *schema.ResourceData) []model.RedirectionRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []model.RedirectionRule
+	lastSequence := int64(0)
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		logged := data["logged"].(bool)
+		tag := data["log_label"].(string)
+		disabled := data["disabled"].(bool)
+		sourcesExcluded := data["sources_excluded"].(bool)
+		destinationsExcluded := data["destinations_excluded"].(bool)
+		action := data["action"].(string)
+
+		var ipProtocol *string
+		ipp := data["ip_version"].(string)
+		if ipp != "NONE" {
+			ipProtocol = &ipp
+		}
+		direction := data["direction"].(string)
+		notes := data["notes"].(string)
+		sequenceNumber := int64(data["sequence_number"].(int))
+		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+
+		id := newUUID()
+		nsxID := data["nsx_id"].(string)
+		if nsxID != "" {
+			id = nsxID
+		}
+
+		if sequenceNumber == 0 || sequenceNumber <= lastSequence {
+			sequenceNumber = lastSequence + 1
+		}
+		lastSequence = sequenceNumber
+
+		resourceType := "RedirectionRule"
+		elem := model.RedirectionRule{
+			ResourceType:         &resourceType,
+			Id:                   &id,
+			DisplayName:          &displayName,
+			Notes:                &notes,
+			Description:          &description,
+			Action:               &action,
+			Logged:               &logged,
+			Tag:                  &tag,
+			Tags:                 tagStructs,
+			Disabled:             &disabled,
+			SourcesExcluded:      &sourcesExcluded,
+			DestinationsExcluded: &destinationsExcluded,
+			IpProtocol:           ipProtocol,
+			Direction:            &direction,
+			SourceGroups:         getPathListFromMap(data, "source_groups"),
+			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+			Services:             getPathListFromMap(data, "services"),
+			Scope:                getPathListFromMap(data, "scope"),
+			Profiles:             getPathListFromMap(data, "profiles"),
+			SequenceNumber:       &sequenceNumber,
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+
+	return ruleList
+}
+
+// North-south redirection is applied on gateways, hence either the policy or each
+// of its rules must be scoped to Tier0 or Tier1 gateway paths
+func validateRedirectionPolicyScope(d *schema.ResourceData) error {
+	if !d.Get("north_south").(bool) || d.Get("scope").(*schema.Set).Len() > 0 {
+		return nil
+	}
+
+	for _, rule := range d.Get("rule").([]interface{}) {
+		data := rule.(map[string]interface{})
+		if data["scope"].(*schema.Set).Len() == 0 {
+			return fmt.Errorf("scope is required for rule %s in north-south redirection policy", data["display_name"].(string))
+		}
+	}
+	return nil
+}
+
+func createPolicyChildRedirectionRule(ruleID string, rule model.RedirectionRule, shouldDelete bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childRule := model.ChildRedirectionRule{
+		ResourceType:    "ChildRedirectionRule",
+		Id:              &ruleID,
+		RedirectionRule: &rule,
+		MarkedForDelete: &shouldDelete,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childRule, model.ChildRedirectionRuleBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func createChildDomainWithRedirectionPolicy(domain string, policyID string, policy model.RedirectionPolicy) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childPolicy := model.ChildRedirectionPolicy{
+		Id:                &policyID,
+		ResourceType:      "ChildRedirectionPolicy",
+		RedirectionPolicy: &policy,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildRedirectionPolicyBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	targetType := "Domain"
+	childDomain := model.ChildResourceReference{
+		Id:           &domain,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &targetType,
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+	}
+
+	dataValue, errors = converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func updateRedirectionPolicy(id string, d *schema.ResourceData, m interface{}) error {
+	domain := d.Get("domain").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	stateful := d.Get("stateful").(bool)
+	northSouth := d.Get("north_south").(bool)
+	resourceType := "RedirectionPolicy"
+
+	obj := model.RedirectionPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		Stateful:       &stateful,
+		NorthSouth:     &northSouth,
+		RedirectTo:     []string{d.Get("redirect_to").(string)},
+		Scope:          getStringListFromSchemaSet(d, "scope"),
+		ResourceType:   &resourceType,
+	}
+
+	var childRules []*data.StructValue
+	if d.HasChange("rule") {
+		oldRules, _ := d.GetChange("rule")
+		rules := getPolicyRedirectionRulesFromSchema(d)
+
+		existingRules := make(map[string]bool)
+		for _, rule := range rules {
+			ruleID := *rule.Id
+			existingRules[ruleID] = true
+
+			childRule, err := createPolicyChildRedirectionRule(ruleID, rule, false)
+			if err != nil {
+				return err
+			}
+			log.Printf("[DEBUG]: Adding child rule with id %s", ruleID)
+			childRules = append(childRules, childRule)
+		}
+
+		// We need to delete old rules that are not present in config anymore
+		for _, oldRule := range oldRules.([]interface{}) {
+			oldRuleMap := oldRule.(map[string]interface{})
+			oldRuleID := oldRuleMap["nsx_id"].(string)
+			if _, exists := existingRules[oldRuleID]; !exists {
+				resourceType := "RedirectionRule"
+				rule := model.RedirectionRule{
+					Id:           &oldRuleID,
+					ResourceType: &resourceType,
+				}
+
+				childRule, err := createPolicyChildRedirectionRule(oldRuleID, rule, true)
+				if err != nil {
+					return err
+				}
+				log.Printf("[DEBUG]: Deleting child rule with id %s", oldRuleID)
+				childRules = append(childRules, childRule)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG]: Updating Redirection policy %s with %d child rules", id, len(childRules))
+	if len(childRules) > 0 {
+		obj.Children = childRules
+	}
+
+	childDomain, err := createChildDomainWithRedirectionPolicy(domain, id, obj)
+	if err != nil {
+		return fmt.Errorf("Failed to create H-API for Redirection Policy: %s", err)
+	}
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     []*data.StructValue{childDomain},
+		ResourceType: &infraType,
+	}
+
+	return policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+}
+
+func resourceNsxtPolicyRedirectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyRedirectionPolicyExistsPartial(d.Get("domain").(string)))
+	if err != nil {
+		return err
+	}
+
+	err = validatePolicyRuleSequence(d)
+	if err != nil {
+		return err
+	}
+
+	err = validateRedirectionPolicyScope(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Redirection Policy with ID %s", id)
+	err = updateRedirectionPolicy(id, d, m)
+	if err != nil {
+		return handleCreateError("Redirection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	client := domains.NewRedirectionPoliciesClient(connector)
+	obj, err := client.Get(d.Get("domain").(string), id)
+	if err != nil {
+		return handleReadError(d, "Redirection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("stateful", obj.Stateful)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("north_south", obj.NorthSouth)
+	if len(obj.RedirectTo) > 0 {
+		d.Set("redirect_to", obj.RedirectTo[0])
+	}
+	d.Set("scope", obj.Scope)
+
+	return setPolicyRedirectionRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyRedirectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	err := validatePolicyRuleSequence(d)
+	if err != nil {
+		return err
+	}
+
+	err = validateRedirectionPolicyScope(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Redirection Policy with ID %s", id)
+	err = updateRedirectionPolicy(id, d, m)
+	if err != nil {
+		return handleUpdateError("Redirection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewRedirectionPoliciesClient(connector)
+	err := client.Delete(d.Get("domain").(string), id)
+	if err != nil {
+		return handleDeleteError("Redirection Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyRedirectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_redirection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceInsertionPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyRedirectionPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "north_south", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "redirect_to"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "REDIRECT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyUpdate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.action", "DO_NOT_REDIRECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.services.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyRedirectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_redirection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceInsertionPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyRedirectionPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyCreate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRedirectionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyRedirectionPolicyExistsInDomain(resourceID, defaultDomain, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Redirection Policy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyRedirectionPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_redirection_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyRedirectionPolicyExistsInDomain(resourceID, defaultDomain, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Redirection Policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyRedirectionPolicyDeps() string {
+	return testAccNsxtPolicyServiceChainTemplate("tf-service-chain", "ALLOW", "ANY") + `
+resource "nsxt_policy_group" "group1" {
+  display_name = "tf-redirection-group1"
+}
+
+resource "nsxt_policy_service" "tcp80" {
+  display_name = "tf-redirection-tcp80"
+  l4_port_set_entry {
+    protocol          = "TCP"
+    destination_ports = ["80"]
+  }
+}`
+}
+
+func testAccNsxtPolicyRedirectionPolicyCreate(name string) string {
+	return testAccNsxtPolicyRedirectionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_redirection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  redirect_to  = nsxt_policy_service_chain.test.path
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+  }
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyRedirectionPolicyUpdate(name string) string {
+	return testAccNsxtPolicyRedirectionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_redirection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  redirect_to  = nsxt_policy_service_chain.test.path
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.group1.path]
+  }
+
+  rule {
+    display_name = "rule2"
+    services     = [nsxt_policy_service.tcp80.path]
+    action       = "DO_NOT_REDIRECT"
+  }
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
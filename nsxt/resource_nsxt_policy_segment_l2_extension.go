@@ -0,0 +1,363 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicySegmentL2Extension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySegmentL2ExtensionCreate,
+		Read:   resourceNsxtPolicySegmentL2ExtensionRead,
+		Update: resourceNsxtPolicySegmentL2ExtensionUpdate,
+		Delete: resourceNsxtPolicySegmentL2ExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySegmentL2ExtensionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"l2vpn_path": getPolicyPathSchema(true, true, "Policy path of L2 VPN session to extend segments over"),
+			"segment": {
+				Type:        schema.TypeSet,
+				Description: "Segments to extend over the L2 VPN session",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment_path": getPolicyPathSchema(true, false, "Policy path of the segment"),
+						"tunnel_id": {
+							Type:         schema.TypeInt,
+							Description:  "Tunnel ID for the segment",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 4093),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Returns map of segment path to tunnel ID, and validates uniqueness within the resource
+func getPolicySegmentL2ExtensionsMap(segmentSet interface{}) (map[string]int64, error) {
+	segments := make(map[string]int64)
+	tunnelIDs := make(map[int64]string)
+	for _, item := range segmentSet.(*schema.Set).List() {
+		data := item.(map[string]interface{})
+		segmentPath := data["segment_path"].(string)
+		tunnelID := int64(data["tunnel_id"].(int))
+		if _, exists := segments[segmentPath]; exists {
+			return nil, fmt.Errorf("Segment %s is specified more than once", segmentPath)
+		}
+		if otherPath, exists := tunnelIDs[tunnelID]; exists {
+			return nil, fmt.Errorf("Tunnel ID %d is used by both %s and %s", tunnelID, otherPath, segmentPath)
+		}
+		segments[segmentPath] = tunnelID
+		tunnelIDs[tunnelID] = segmentPath
+	}
+
+	return segments, nil
+}
+
+func listPolicySegmentsExtendedOverL2VPN(connector client.Connector, l2vpnPath string) ([]model.Segment, error) {
+	query := fmt.Sprintf("resource_type:Segment AND l2_extension.l2vpn_paths:%s AND marked_for_delete:false", escapeSpecialCharacters(l2vpnPath))
+	results, err := searchLMPolicyResources(connector, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []model.Segment
+	converter := bindings.NewTypeConverter()
+	for _, result := range results {
+		dataValue, errors := converter.ConvertToGolang(result, model.SegmentBindingType())
+		if len(errors) > 0 {
+			return nil, errors[0]
+		}
+		segment := dataValue.(model.Segment)
+		if segment.Path == nil || segment.L2Extension == nil || segment.L2Extension.TunnelId == nil {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+
+	return segments, nil
+}
+
+// Tunnel ID must be unique among all segments extended over the same L2 VPN session
+func validatePolicySegmentL2ExtensionTunnelIDs(connector client.Connector, l2vpnPath string, segments map[string]int64, previous map[string]int64) error {
+	extendedSegments, err := listPolicySegmentsExtendedOverL2VPN(connector, l2vpnPath)
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range extendedSegments {
+		_, managed := segments[*segment.Path]
+		_, wasManaged := previous[*segment.Path]
+		if managed || wasManaged {
+			continue
+		}
+		for segmentPath, tunnelID := range segments {
+			if tunnelID == *segment.L2Extension.TunnelId {
+				return fmt.Errorf("Tunnel ID %d for segment %s is already used by segment %s on L2 VPN session %s", tunnelID, segmentPath, *segment.Path, l2vpnPath)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getPolicySegmentIDFromL2ExtensionPath(segmentPath string) (string, error) {
+	// Only infra segments can be extended, path should be like /infra/segments/aaa
+	segs := strings.Split(segmentPath, "/")
+	if len(segs) != 4 || segs[1] != "infra" || segs[2] != "segments" {
+		return "", fmt.Errorf("Invalid segment path %s", segmentPath)
+	}
+
+	return segs[3], nil
+}
+
+func setPolicySegmentL2Extension(context utl.SessionContext, connector client.Connector, segmentPath string, l2vpnPath string, tunnelID *int64) error {
+	segmentID, err := getPolicySegmentIDFromL2ExtensionPath(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	client := infra.NewSegmentsClient(context, connector)
+	obj, err := client.Get(segmentID)
+	if err != nil {
+		return err
+	}
+
+	// Only the path of this session is added or removed, extension over other
+	// sessions and local egress settings are kept intact
+	if tunnelID == nil {
+		if obj.L2Extension == nil || !stringInList(l2vpnPath, obj.L2Extension.L2vpnPaths) {
+			// Extension was already removed
+			return nil
+		}
+		log.Printf("[INFO] Removing L2 extension over %s from segment %s", l2vpnPath, segmentPath)
+		var paths []string
+		for _, path := range obj.L2Extension.L2vpnPaths {
+			if path != l2vpnPath {
+				paths = append(paths, path)
+			}
+		}
+		obj.L2Extension.L2vpnPaths = paths
+		if len(paths) == 0 {
+			obj.L2Extension.TunnelId = nil
+		}
+	} else {
+		log.Printf("[INFO] Extending segment %s over %s with tunnel ID %d", segmentPath, l2vpnPath, *tunnelID)
+		if obj.L2Extension == nil {
+			obj.L2Extension = &model.L2Extension{}
+		}
+		// Tunnel ID is shared by all sessions the segment is extended over
+		for _, path := range obj.L2Extension.L2vpnPaths {
+			if path != l2vpnPath && obj.L2Extension.TunnelId != nil && *obj.L2Extension.TunnelId != *tunnelID {
+				return fmt.Errorf("Segment %s is extended over %s with tunnel ID %d, which conflicts with tunnel ID %d", segmentPath, path, *obj.L2Extension.TunnelId, *tunnelID)
+			}
+		}
+		if !stringInList(l2vpnPath, obj.L2Extension.L2vpnPaths) {
+			obj.L2Extension.L2vpnPaths = append(obj.L2Extension.L2vpnPaths, l2vpnPath)
+		}
+		obj.L2Extension.TunnelId = tunnelID
+	}
+
+	_, err = client.Update(segmentID, obj)
+	return err
+}
+
+// Only segments that were added, removed, or had their tunnel ID changed are updated
+func resourceNsxtPolicySegmentL2ExtensionApply(d *schema.ResourceData, m interface{}, previous map[string]int64) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	l2vpnPath := d.Get("l2vpn_path").(string)
+	segments, err := getPolicySegmentL2ExtensionsMap(d.Get("segment"))
+	if err != nil {
+		return err
+	}
+
+	err = validatePolicySegmentL2ExtensionTunnelIDs(connector, l2vpnPath, segments, previous)
+	if err != nil {
+		return err
+	}
+
+	// Detach segments first to free up their tunnel IDs
+	for segmentPath := range previous {
+		if _, exists := segments[segmentPath]; exists {
+			continue
+		}
+		err = setPolicySegmentL2Extension(context, connector, segmentPath, l2vpnPath, nil)
+		if err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+
+	for segmentPath, tunnelID := range segments {
+		if previousTunnelID, exists := previous[segmentPath]; exists && previousTunnelID == tunnelID {
+			continue
+		}
+		tunnelID := tunnelID
+		err = setPolicySegmentL2Extension(context, connector, segmentPath, l2vpnPath, &tunnelID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentL2ExtensionCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// ID is set before segments are extended, so that segments extended before
+	// a failure are detached when the tainted resource is destroyed
+	id := newUUID()
+	d.SetId(id)
+	err := resourceNsxtPolicySegmentL2ExtensionApply(d, m, make(map[string]int64))
+	if err != nil {
+		return handleCreateError("Segment L2 Extension", id, err)
+	}
+
+	return resourceNsxtPolicySegmentL2ExtensionRead(d, m)
+}
+
+// Segments are retrieved with single search query. Search index might not reflect
+// most recent changes yet, hence segments missing in search results are retrieved
+// one by one.
+func resourceNsxtPolicySegmentL2ExtensionRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := infra.NewSegmentsClient(getSessionContext(d, m), connector)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment L2 Extension ID")
+	}
+
+	l2vpnPath := d.Get("l2vpn_path").(string)
+	extendedSegments, err := listPolicySegmentsExtendedOverL2VPN(connector, l2vpnPath)
+	if err != nil {
+		return handleReadError(d, "Segment L2 Extension", id, err)
+	}
+	extensions := make(map[string]*model.L2Extension)
+	for _, segment := range extendedSegments {
+		extensions[*segment.Path] = segment.L2Extension
+	}
+
+	var segmentList []map[string]interface{}
+	for _, item := range d.Get("segment").(*schema.Set).List() {
+		segmentPath := item.(map[string]interface{})["segment_path"].(string)
+		extension, found := extensions[segmentPath]
+		if !found {
+			segmentID, err := getPolicySegmentIDFromL2ExtensionPath(segmentPath)
+			if err != nil {
+				return err
+			}
+			obj, err := client.Get(segmentID)
+			if err != nil {
+				if isNotFoundError(err) {
+					log.Printf("[DEBUG] Segment %s not found", segmentPath)
+					continue
+				}
+				return handleReadError(d, "Segment L2 Extension", id, err)
+			}
+			extension = obj.L2Extension
+		}
+		if extension == nil || extension.TunnelId == nil || !stringInList(l2vpnPath, extension.L2vpnPaths) {
+			// Extension was removed outside of terraform
+			continue
+		}
+
+		elem := make(map[string]interface{})
+		elem["segment_path"] = segmentPath
+		elem["tunnel_id"] = extension.TunnelId
+		segmentList = append(segmentList, elem)
+	}
+
+	return d.Set("segment", segmentList)
+}
+
+func resourceNsxtPolicySegmentL2ExtensionUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment L2 Extension ID")
+	}
+
+	oldSegments, _ := d.GetChange("segment")
+	previous, err := getPolicySegmentL2ExtensionsMap(oldSegments)
+	if err != nil {
+		return handleUpdateError("Segment L2 Extension", id, err)
+	}
+	err = resourceNsxtPolicySegmentL2ExtensionApply(d, m, previous)
+	if err != nil {
+		return handleUpdateError("Segment L2 Extension", id, err)
+	}
+
+	return resourceNsxtPolicySegmentL2ExtensionRead(d, m)
+}
+
+func resourceNsxtPolicySegmentL2ExtensionDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment L2 Extension ID")
+	}
+
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	l2vpnPath := d.Get("l2vpn_path").(string)
+	for _, item := range d.Get("segment").(*schema.Set).List() {
+		segmentPath := item.(map[string]interface{})["segment_path"].(string)
+		err := setPolicySegmentL2Extension(context, connector, segmentPath, l2vpnPath, nil)
+		if err != nil && !isNotFoundError(err) {
+			return handleDeleteError("Segment L2 Extension", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentL2ExtensionImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	l2vpnPath := d.Id()
+	if !isPolicyPath(l2vpnPath) {
+		return nil, fmt.Errorf("Policy path of L2 VPN session is expected, got %s", l2vpnPath)
+	}
+
+	segments, err := listPolicySegmentsExtendedOverL2VPN(getPolicyConnector(m), l2vpnPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("No segments are extended over L2 VPN session %s", l2vpnPath)
+	}
+
+	var segmentList []map[string]interface{}
+	for _, segment := range segments {
+		if !stringInList(l2vpnPath, segment.L2Extension.L2vpnPaths) {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["segment_path"] = segment.Path
+		elem["tunnel_id"] = segment.L2Extension.TunnelId
+		segmentList = append(segmentList, elem)
+	}
+
+	d.SetId(newUUID())
+	d.Set("l2vpn_path", l2vpnPath)
+	d.Set("segment", segmentList)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,106 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySegmentL2Extension_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_segment_l2_extension.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t); testAccNSXVersion(t, "3.2.0") },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicySegmentL2ExtensionTemplate(10, 10, true),
+				ExpectError: regexp.MustCompile(`Tunnel ID 10 is used by both`),
+			},
+			{
+				Config: testAccNsxtPolicySegmentL2ExtensionTemplate(10, 11, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "l2vpn_path"),
+					resource.TestCheckResourceAttr(testResourceName, "segment.#", "2"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentL2ExtensionTemplate(12, 11, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "l2vpn_path"),
+					resource.TestCheckResourceAttr(testResourceName, "segment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(testResourceName, "segment.*", map[string]string{
+						"tunnel_id": "12",
+					}),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccNsxtPolicySegmentL2ExtensionImporterGetID,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("Expected one imported Segment L2 Extension, got %d", len(states))
+					}
+					if count := states[0].Attributes["segment.#"]; count != "1" {
+						return fmt.Errorf("Expected one imported segment, got %s", count)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentL2ExtensionImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_segment_l2_extension.test"]
+	if !ok {
+		return "", fmt.Errorf("Segment L2 Extension resource not found in resources")
+	}
+	l2vpnPath := rs.Primary.Attributes["l2vpn_path"]
+	if l2vpnPath == "" {
+		return "", fmt.Errorf("Segment L2 Extension l2vpn_path not set in resources")
+	}
+	return l2vpnPath, nil
+}
+
+func testAccNsxtPolicySegmentL2ExtensionTemplate(tunnelID1 int, tunnelID2 int, withSecond bool) string {
+	second := ""
+	if withSecond {
+		second = fmt.Sprintf(`
+  segment {
+    segment_path = nsxt_policy_segment.test2.path
+    tunnel_id    = %d
+  }`, tunnelID2)
+	}
+	return testAccNsxtPolicyL2VpnSessionMinimalistic(false) + fmt.Sprintf(`
+data "nsxt_policy_transport_zone" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_segment" "test1" {
+  display_name        = "terraform-l2ext-1"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+
+resource "nsxt_policy_segment" "test2" {
+  display_name        = "terraform-l2ext-2"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+
+resource "nsxt_policy_segment_l2_extension" "test" {
+  l2vpn_path = nsxt_policy_l2_vpn_session.test.path
+
+  segment {
+    segment_path = nsxt_policy_segment.test1.path
+    tunnel_id    = %d
+  }
+  %s
+}`, getOverlayTransportZoneName(), tunnelID1, second)
+}
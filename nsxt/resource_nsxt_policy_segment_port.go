@@ -0,0 +1,660 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments"
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments/ports"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var segmentPortAttachmentTypeValues = []string{
+	model.PortAttachment_TYPE_PARENT,
+	model.PortAttachment_TYPE_CHILD,
+	model.PortAttachment_TYPE_INDEPENDENT,
+	model.PortAttachment_TYPE_STATIC,
+}
+
+var segmentPortAllocateAddressesValues = []string{
+	model.PortAttachment_ALLOCATE_ADDRESSES_IP_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_MAC_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_BOTH,
+	model.PortAttachment_ALLOCATE_ADDRESSES_NONE,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCP,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCPV6,
+	model.PortAttachment_ALLOCATE_ADDRESSES_SLAAC,
+}
+
+var segmentPortAdminStateValues = []string{
+	model.SegmentPort_ADMIN_STATE_UP,
+	model.SegmentPort_ADMIN_STATE_DOWN,
+}
+
+func resourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySegmentPortCreate,
+		Read:   resourceNsxtPolicySegmentPortRead,
+		Update: resourceNsxtPolicySegmentPortUpdate,
+		Delete: resourceNsxtPolicySegmentPortDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtSegmentPortResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(),
+			"segment_path": getPolicyPathSchema(true, true, "Path of parent segment"),
+			"admin_state": {
+				Type:         schema.TypeString,
+				Description:  "Administrative state of the port",
+				Optional:     true,
+				Default:      model.SegmentPort_ADMIN_STATE_UP,
+				ValidateFunc: validation.StringInSlice(segmentPortAdminStateValues, false),
+			},
+			"attachment": {
+				Type:        schema.TypeList,
+				Description: "VIF attachment",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "VIF UUID on NSX",
+							Optional:    true,
+							Computed:    true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of port attachment",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(segmentPortAttachmentTypeValues, false),
+						},
+						"context_id": {
+							Type:        schema.TypeString,
+							Description: "Attachment ID of parent VIF for CHILD attachment, or transport node ID for INDEPENDENT attachment",
+							Optional:    true,
+						},
+						"traffic_tag": {
+							Type:         schema.TypeInt,
+							Description:  "VLAN ID used to tag traffic of CHILD attachment",
+							Optional:     true,
+							ValidateFunc: validateVLANId,
+						},
+						"app_id": {
+							Type:        schema.TypeString,
+							Description: "ID used to identify or look up a child VIF attachment",
+							Optional:    true,
+						},
+						"allocate_addresses": {
+							Type:         schema.TypeString,
+							Description:  "Indicate how IP and MAC addresses are allocated to the port",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(segmentPortAllocateAddressesValues, false),
+						},
+					},
+				},
+			},
+			"address_binding": {
+				Type:        schema.TypeList,
+				Description: "Static address bindings for the port",
+				Optional:    true,
+				MaxItems:    512,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Description:  "IP address or CIDR",
+							Optional:     true,
+							ValidateFunc: validation.Any(validateSingleIP(), validateIPCidr()),
+						},
+						"mac_address": {
+							Type:         schema.TypeString,
+							Description:  "MAC address",
+							Optional:     true,
+							ValidateFunc: validation.IsMACAddress,
+						},
+						"vlan_id": {
+							Type:         schema.TypeInt,
+							Description:  "VLAN ID",
+							Optional:     true,
+							ValidateFunc: validateVLANId,
+						},
+					},
+				},
+			},
+			"discovery_profile": {
+				Type:        schema.TypeList,
+				Description: "IP and MAC discovery profiles for this port",
+				Elem:        getPolicySegmentDiscoveryProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"qos_profile": {
+				Type:        schema.TypeList,
+				Description: "QoS profiles for this port",
+				Elem:        getPolicySegmentQosProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"security_profile": {
+				Type:        schema.TypeList,
+				Description: "Security profiles for this port",
+				Elem:        getPolicySegmentSecurityProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+		},
+	}
+}
+
+func getPolicySegmentPortSegmentID(segmentPath string) (string, error) {
+	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if segmentID == "" {
+		return "", fmt.Errorf("Invalid Segment Path %s", segmentPath)
+	}
+	if gwID != "" {
+		return "", fmt.Errorf("Ports on fixed segments are not supported, segment path %s", segmentPath)
+	}
+
+	return segmentID, nil
+}
+
+func resourceNsxtPolicySegmentPortExistsOnSegment(context utl.SessionContext, id string, segmentPath string, connector client.Connector) (bool, error) {
+	segmentID, err := getPolicySegmentPortSegmentID(segmentPath)
+	if err != nil {
+		return false, err
+	}
+
+	client := segments.NewPortsClient(context, connector)
+	_, err = client.Get(segmentID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicySegmentPortExists(segmentPath string) func(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return func(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		return resourceNsxtPolicySegmentPortExistsOnSegment(context, id, segmentPath, connector)
+	}
+}
+
+func getPolicySegmentPortAttachmentFromSchema(d *schema.ResourceData) *model.PortAttachment {
+	attachments := d.Get("attachment").([]interface{})
+	if len(attachments) == 0 || attachments[0] == nil {
+		return nil
+	}
+
+	data := attachments[0].(map[string]interface{})
+	var attachment model.PortAttachment
+	attachmentID := data["id"].(string)
+	if len(attachmentID) > 0 {
+		attachment.Id = &attachmentID
+	}
+	attachmentType := data["type"].(string)
+	if len(attachmentType) > 0 {
+		attachment.Type_ = &attachmentType
+	}
+	contextID := data["context_id"].(string)
+	if len(contextID) > 0 {
+		attachment.ContextId = &contextID
+	}
+	appID := data["app_id"].(string)
+	if len(appID) > 0 {
+		attachment.AppId = &appID
+	}
+	allocateAddresses := data["allocate_addresses"].(string)
+	if len(allocateAddresses) > 0 {
+		attachment.AllocateAddresses = &allocateAddresses
+	}
+
+	trafficTag := int64(data["traffic_tag"].(int))
+	if trafficTag > 0 {
+		attachment.TrafficTag = &trafficTag
+	}
+
+	return &attachment
+}
+
+func setPolicySegmentPortAttachmentInSchema(d *schema.ResourceData, attachment *model.PortAttachment) {
+	var attachmentList []map[string]interface{}
+	if attachment != nil {
+		elem := make(map[string]interface{})
+		elem["id"] = attachment.Id
+		elem["type"] = attachment.Type_
+		elem["context_id"] = attachment.ContextId
+		elem["traffic_tag"] = attachment.TrafficTag
+		elem["app_id"] = attachment.AppId
+		elem["allocate_addresses"] = attachment.AllocateAddresses
+		attachmentList = append(attachmentList, elem)
+	}
+
+	d.Set("attachment", attachmentList)
+}
+
+func getPolicySegmentPortAddressBindingsFromSchema(d *schema.ResourceData) []model.PortAddressBindingEntry {
+	var bindings []model.PortAddressBindingEntry
+	for _, binding := range d.Get("address_binding").([]interface{}) {
+		data := binding.(map[string]interface{})
+		ipAddress := data["ip_address"].(string)
+		macAddress := data["mac_address"].(string)
+		vlanID := int64(data["vlan_id"].(int))
+		elem := model.PortAddressBindingEntry{}
+		if len(ipAddress) > 0 {
+			elem.IpAddress = &ipAddress
+		}
+		if len(macAddress) > 0 {
+			elem.MacAddress = &macAddress
+		}
+		if vlanID > 0 {
+			elem.VlanId = &vlanID
+		}
+		bindings = append(bindings, elem)
+	}
+
+	return bindings
+}
+
+func setPolicySegmentPortAddressBindingsInSchema(d *schema.ResourceData, bindings []model.PortAddressBindingEntry) {
+	var bindingList []map[string]interface{}
+	for _, binding := range bindings {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = binding.IpAddress
+		elem["mac_address"] = binding.MacAddress
+		elem["vlan_id"] = binding.VlanId
+		bindingList = append(bindingList, elem)
+	}
+
+	d.Set("address_binding", bindingList)
+}
+
+func nsxtPolicySegmentPortDiscoveryProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
+	config := getPolicySegmentProfileMapConfig(d, "discovery_profile")
+	if config == nil {
+		return nil, nil
+	}
+
+	resourceType := "PortDiscoveryProfileBindingMap"
+	discoveryMap := model.PortDiscoveryProfileBindingMap{
+		ResourceType:            &resourceType,
+		Id:                      &config.id,
+		Revision:                config.revision,
+		IpDiscoveryProfilePath:  config.getProfilePath("ip_discovery_profile_path"),
+		MacDiscoveryProfilePath: config.getProfilePath("mac_discovery_profile_path"),
+	}
+
+	childConfig := model.ChildPortDiscoveryProfileBindingMap{
+		ResourceType:                   "ChildPortDiscoveryProfileBindingMap",
+		PortDiscoveryProfileBindingMap: &discoveryMap,
+		Id:                             &config.id,
+		MarkedForDelete:                &config.shouldDelete,
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortDiscoveryProfileBindingMapBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child port discovery map: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func nsxtPolicySegmentPortQosProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
+	config := getPolicySegmentProfileMapConfig(d, "qos_profile")
+	if config == nil {
+		return nil, nil
+	}
+
+	resourceType := "PortQoSProfileBindingMap"
+	qosMap := model.PortQosProfileBindingMap{
+		ResourceType:   &resourceType,
+		Id:             &config.id,
+		Revision:       config.revision,
+		QosProfilePath: config.getProfilePath("qos_profile_path"),
+	}
+
+	childConfig := model.ChildPortQosProfileBindingMap{
+		ResourceType:             "ChildPortQoSProfileBindingMap",
+		PortQosProfileBindingMap: &qosMap,
+		Id:                       &config.id,
+		MarkedForDelete:          &config.shouldDelete,
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortQosProfileBindingMapBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child port QoS map: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func nsxtPolicySegmentPortSecurityProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
+	config := getPolicySegmentProfileMapConfig(d, "security_profile")
+	if config == nil {
+		return nil, nil
+	}
+
+	resourceType := "PortSecurityProfileBindingMap"
+	securityMap := model.PortSecurityProfileBindingMap{
+		ResourceType:               &resourceType,
+		Id:                         &config.id,
+		Revision:                   config.revision,
+		SpoofguardProfilePath:      config.getProfilePath("spoofguard_profile_path"),
+		SegmentSecurityProfilePath: config.getProfilePath("security_profile_path"),
+	}
+
+	childConfig := model.ChildPortSecurityProfileBindingMap{
+		ResourceType:                  "ChildPortSecurityProfileBindingMap",
+		PortSecurityProfileBindingMap: &securityMap,
+		Id:                            &config.id,
+		MarkedForDelete:               &config.shouldDelete,
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortSecurityProfileBindingMapBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child port security map: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func nsxtPolicySegmentPortProfilesSetInStruct(d *schema.ResourceData, port *model.SegmentPort) error {
+	var children []*data.StructValue
+	for _, setter := range []func(*schema.ResourceData) (*data.StructValue, error){
+		nsxtPolicySegmentPortDiscoveryProfileSetInStruct,
+		nsxtPolicySegmentPortQosProfileSetInStruct,
+		nsxtPolicySegmentPortSecurityProfileSetInStruct,
+	} {
+		child, err := setter(d)
+		if err != nil {
+			return err
+		}
+
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+
+	port.Children = children
+	return nil
+}
+
+func policySegmentPortResourceToInfraStruct(id string, segmentID string, d *schema.ResourceData) (model.Infra, error) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	adminState := d.Get("admin_state").(string)
+	resourceType := "SegmentPort"
+
+	obj := model.SegmentPort{
+		Id:              &id,
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		AdminState:      &adminState,
+		Attachment:      getPolicySegmentPortAttachmentFromSchema(d),
+		AddressBindings: getPolicySegmentPortAddressBindingsFromSchema(d),
+		ResourceType:    &resourceType,
+	}
+
+	if !d.IsNewResource() {
+		// This is an update
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	if err := nsxtPolicySegmentPortProfilesSetInStruct(d, &obj); err != nil {
+		return model.Infra{}, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	childPort := model.ChildSegmentPort{
+		SegmentPort:  &obj,
+		ResourceType: "ChildSegmentPort",
+	}
+	dataValue, errs := converter.ConvertToVapi(childPort, model.ChildSegmentPortBindingType())
+	if errs != nil {
+		return model.Infra{}, fmt.Errorf("Error converting Segment Port Child: %v", errs[0])
+	}
+
+	targetType := "Segment"
+	childSegment := model.ChildResourceReference{
+		Id:           &segmentID,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &targetType,
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+	}
+	segmentValue, errs := converter.ConvertToVapi(childSegment, model.ChildResourceReferenceBindingType())
+	if errs != nil {
+		return model.Infra{}, fmt.Errorf("Error converting Segment Child: %v", errs[0])
+	}
+
+	infraType := "Infra"
+	return model.Infra{
+		Children:     []*data.StructValue{segmentValue.(*data.StructValue)},
+		ResourceType: &infraType,
+	}, nil
+}
+
+func nsxtPolicySegmentPortProfilesRead(d *schema.ResourceData, m interface{}, segmentID string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	portID := d.Id()
+
+	discoveryClient := ports.NewPortDiscoveryProfileBindingMapsClient(context, connector)
+	discoveryMaps, err := discoveryClient.List(segmentID, portID, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read Discovery Profile Map for port %s: %s", portID, err)
+	}
+	var discoveryList []map[string]interface{}
+	for _, obj := range discoveryMaps.Results {
+		config := make(map[string]interface{})
+		config["ip_discovery_profile_path"] = obj.IpDiscoveryProfilePath
+		config["mac_discovery_profile_path"] = obj.MacDiscoveryProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		discoveryList = append(discoveryList, config)
+		break
+	}
+	d.Set("discovery_profile", discoveryList)
+
+	qosClient := ports.NewPortQosProfileBindingMapsClient(context, connector)
+	qosMaps, err := qosClient.List(segmentID, portID, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read QoS Profile Map for port %s: %s", portID, err)
+	}
+	var qosList []map[string]interface{}
+	for _, obj := range qosMaps.Results {
+		config := make(map[string]interface{})
+		config["qos_profile_path"] = obj.QosProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		qosList = append(qosList, config)
+		break
+	}
+	d.Set("qos_profile", qosList)
+
+	securityClient := ports.NewPortSecurityProfileBindingMapsClient(context, connector)
+	securityMaps, err := securityClient.List(segmentID, portID, nil, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to read Security Profile Map for port %s: %s", portID, err)
+	}
+	var securityList []map[string]interface{}
+	for _, obj := range securityMaps.Results {
+		config := make(map[string]interface{})
+		config["security_profile_path"] = obj.SegmentSecurityProfilePath
+		config["spoofguard_profile_path"] = obj.SpoofguardProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		securityList = append(securityList, config)
+		break
+	}
+	d.Set("security_profile", securityList)
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentPortCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	segmentID, err := getPolicySegmentPortSegmentID(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySegmentPortExists(segmentPath))
+	if err != nil {
+		return err
+	}
+
+	obj, err := policySegmentPortResourceToInfraStruct(id, segmentID, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Segment Port with ID %s on segment %s", id, segmentPath)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
+	if err != nil {
+		return handleCreateError("Segment Port", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func resourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	segmentID, err := getPolicySegmentPortSegmentID(segmentPath)
+	if err != nil {
+		return err
+	}
+
+	client := segments.NewPortsClient(getSessionContext(d, m), connector)
+	obj, err := client.Get(segmentID, id)
+	if err != nil {
+		return handleReadError(d, "Segment Port", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("admin_state", obj.AdminState)
+	setPolicySegmentPortAttachmentInSchema(d, obj.Attachment)
+	setPolicySegmentPortAddressBindingsInSchema(d, obj.AddressBindings)
+
+	return nsxtPolicySegmentPortProfilesRead(d, m, segmentID)
+}
+
+func resourceNsxtPolicySegmentPortUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	obj, err := policySegmentPortResourceToInfraStruct(id, segmentID, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Segment Port with ID %s", id)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), true)
+	if err != nil {
+		return handleUpdateError("Segment Port", id, err)
+	}
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func resourceNsxtPolicySegmentPortDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+
+	segmentID, err := getPolicySegmentPortSegmentID(d.Get("segment_path").(string))
+	if err != nil {
+		return err
+	}
+
+	client := segments.NewPortsClient(getSessionContext(d, m), getPolicyConnector(m))
+	err = client.Delete(segmentID, id)
+	if err != nil {
+		return handleDeleteError("Segment Port", id, err)
+	}
+
+	return nil
+}
+
+func nsxtSegmentPortResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err == nil {
+		segmentPath, err := getParameterFromPolicyPath("", "/ports/", importID)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("segment_path", segmentPath)
+		return rd, nil
+	} else if !errors.Is(err, ErrNotAPolicyPath) {
+		return rd, err
+	}
+
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return []*schema.ResourceData{d}, fmt.Errorf("Import format segmentID/portID expected, got %s", importID)
+	}
+
+	d.SetId(s[1])
+	d.Set("segment_path", fmt.Sprintf("/infra/segments/%s", s[0]))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,213 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_segment_port.test"
+	tzName := getOverlayTransportZoneName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(tzName, name, "UP", "10.10.10.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "UP"),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.0.type", "INDEPENDENT"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", "10.10.10.10"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.mac_address", "00:50:56:aa:bb:cc"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "discovery_profile.0.ip_discovery_profile_path"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "security_profile.0.spoofguard_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "segment_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(tzName, updatedName, "DOWN", "10.10.10.20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", "DOWN"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", "10.10.10.20"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortMinimalTemplate(tzName, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "discovery_profile.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_segment_port.test"
+	tzName := getOverlayTransportZoneName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(tzName, name, "UP", "10.10.10.10"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Segment Port resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Segment Port resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySegmentPortExistsOnSegment(testAccGetSessionContext(), resourceID, rs.Primary.Attributes["segment_path"], connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Segment Port %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySegmentPortCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_segment_port" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySegmentPortExistsOnSegment(testAccGetSessionContext(), resourceID, rs.Primary.Attributes["segment_path"], connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Segment Port %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySegmentPortDeps(tzName string, name string) string {
+	return testAccNSXPolicyTransportZoneReadTemplate(tzName, false, true) + fmt.Sprintf(`
+data "nsxt_policy_spoofguard_profile" "test" {
+  display_name = "default-spoofguard-profile"
+}
+
+data "nsxt_policy_ip_discovery_profile" "test" {
+  display_name = "default-ip-discovery-profile"
+}
+
+data "nsxt_policy_mac_discovery_profile" "test" {
+  display_name = "default-mac-discovery-profile"
+}
+
+resource "nsxt_policy_segment" "test" {
+  display_name        = "%s"
+  transport_zone_path = data.nsxt_policy_transport_zone.test.path
+}
+`, name)
+}
+
+func testAccNsxtPolicySegmentPortTemplate(tzName string, name string, adminState string, ipAddress string) string {
+	return testAccNsxtPolicySegmentPortDeps(tzName, name) + fmt.Sprintf(`
+resource "nsxt_policy_segment_port" "test" {
+  display_name = "%s"
+  segment_path = nsxt_policy_segment.test.path
+  admin_state  = "%s"
+
+  attachment {
+    type = "INDEPENDENT"
+  }
+
+  address_binding {
+    ip_address  = "%s"
+    mac_address = "00:50:56:aa:bb:cc"
+  }
+
+  discovery_profile {
+    ip_discovery_profile_path  = data.nsxt_policy_ip_discovery_profile.test.path
+    mac_discovery_profile_path = data.nsxt_policy_mac_discovery_profile.test.path
+  }
+
+  security_profile {
+    spoofguard_profile_path = data.nsxt_policy_spoofguard_profile.test.path
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, adminState, ipAddress)
+}
+
+func testAccNsxtPolicySegmentPortMinimalTemplate(tzName string, name string) string {
+	return testAccNsxtPolicySegmentPortDeps(tzName, name) + fmt.Sprintf(`
+resource "nsxt_policy_segment_port" "test" {
+  display_name = "%s"
+  segment_path = nsxt_policy_segment.test.path
+}`, name)
+}
@@ -0,0 +1,207 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyServiceChainFailurePolicyValues = []string{
+	model.PolicyServiceChain_FAILURE_POLICY_ALLOW,
+	model.PolicyServiceChain_FAILURE_POLICY_BLOCK,
+}
+
+var policyServiceChainPathSelectionPolicyValues = []string{
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_ANY,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_LOCAL,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_REMOTE,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_ROUND_ROBIN,
+}
+
+func resourceNsxtPolicyServiceChain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyServiceChainCreate,
+		Read:   resourceNsxtPolicyServiceChainRead,
+		Update: resourceNsxtPolicyServiceChainUpdate,
+		Delete: resourceNsxtPolicyServiceChainDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":               getNsxIDSchema(),
+			"path":                 getPathSchema(),
+			"display_name":         getDisplayNameSchema(),
+			"description":          getDescriptionSchema(),
+			"revision":             getRevisionSchema(),
+			"tag":                  getTagsSchema(),
+			"service_segment_path": getPolicyPathSchema(true, false, "Path of service segment this chain belongs to"),
+			"forward_path_service_profiles": {
+				Type:        schema.TypeList,
+				Description: "Ordered list of service profile paths for forward direction of traffic",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"reverse_path_service_profiles": {
+				Type:        schema.TypeList,
+				Description: "Ordered list of service profile paths for reverse direction of traffic",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"failure_policy": {
+				Type:         schema.TypeString,
+				Description:  "Action to be taken on traffic during failure scenarios",
+				Optional:     true,
+				Default:      model.PolicyServiceChain_FAILURE_POLICY_ALLOW,
+				ValidateFunc: validation.StringInSlice(policyServiceChainFailurePolicyValues, false),
+			},
+			"path_selection_policy": {
+				Type:         schema.TypeString,
+				Description:  "Path selection policy for service instances",
+				Optional:     true,
+				Default:      model.PolicyServiceChain_PATH_SELECTION_POLICY_ANY,
+				ValidateFunc: validation.StringInSlice(policyServiceChainPathSelectionPolicyValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyServiceChainExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewServiceChainsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Service Chain", err)
+}
+
+func resourceNsxtPolicyServiceChainPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	failurePolicy := d.Get("failure_policy").(string)
+	pathSelectionPolicy := d.Get("path_selection_policy").(string)
+
+	obj := model.PolicyServiceChain{
+		DisplayName:                &displayName,
+		Description:                &description,
+		Tags:                       tags,
+		ServiceSegmentPath:         []string{d.Get("service_segment_path").(string)},
+		ForwardPathServiceProfiles: interfaceListToStringList(d.Get("forward_path_service_profiles").([]interface{})),
+		FailurePolicy:              &failurePolicy,
+		PathSelectionPolicy:        &pathSelectionPolicy,
+	}
+
+	reverseProfiles := interfaceListToStringList(d.Get("reverse_path_service_profiles").([]interface{}))
+	if len(reverseProfiles) > 0 {
+		obj.ReversePathServiceProfiles = reverseProfiles
+	}
+
+	log.Printf("[INFO] Patching Service Chain with ID %s", id)
+	client := infra.NewServiceChainsClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyServiceChainCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyServiceChainExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyServiceChainPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Service Chain", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyServiceChainRead(d, m)
+}
+
+func resourceNsxtPolicyServiceChainRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	client := infra.NewServiceChainsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Service Chain", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	if len(obj.ServiceSegmentPath) > 0 {
+		d.Set("service_segment_path", obj.ServiceSegmentPath[0])
+	}
+	d.Set("forward_path_service_profiles", obj.ForwardPathServiceProfiles)
+	d.Set("reverse_path_service_profiles", obj.ReversePathServiceProfiles)
+	d.Set("failure_policy", obj.FailurePolicy)
+	d.Set("path_selection_policy", obj.PathSelectionPolicy)
+
+	return nil
+}
+
+func resourceNsxtPolicyServiceChainUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	err := resourceNsxtPolicyServiceChainPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Service Chain", id, err)
+	}
+
+	return resourceNsxtPolicyServiceChainRead(d, m)
+}
+
+func resourceNsxtPolicyServiceChainDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewServiceChainsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Service Chain", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyServiceInsertionPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_SERVICE_PROFILE_PATH")
+	testAccEnvDefined(t, "NSXT_TEST_SERVICE_SEGMENT_PATH")
+}
+
+func TestAccResourceNsxtPolicyServiceChain_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_service_chain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceInsertionPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyServiceChainCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", "ANY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceChainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "path_selection_policy", "ANY"),
+					resource.TestCheckResourceAttr(testResourceName, "forward_path_service_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "service_segment_path", getTestServiceSegmentPath()),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(updatedName, "BLOCK", "LOCAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceChainExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "BLOCK"),
+					resource.TestCheckResourceAttr(testResourceName, "path_selection_policy", "LOCAL"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyServiceChain_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_service_chain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceInsertionPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyServiceChainCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", "ANY"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceChainExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Service Chain resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Service Chain resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyServiceChainExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Service Chain %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyServiceChainCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_service_chain" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyServiceChainExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Service Chain %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyServiceChainTemplate(name string, failurePolicy string, pathSelectionPolicy string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_service_chain" "test" {
+  display_name                  = "%s"
+  description                   = "Acceptance Test"
+  service_segment_path          = "%s"
+  forward_path_service_profiles = ["%s"]
+  failure_policy                = "%s"
+  path_selection_policy         = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestServiceSegmentPath(), getTestServiceProfilePath(), failurePolicy, pathSelectionPolicy)
+}
@@ -0,0 +1,187 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicySignedCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySignedCertificateCreate,
+		Read:   resourceNsxtPolicySignedCertificateRead,
+		Delete: resourceNsxtPolicySignedCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		// Certificate is issued once and can not be modified
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+			"csr_path": {
+				Type:             schema.TypeString,
+				Description:      "Policy path of the certificate signing request",
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validatePolicyPath(),
+				DiffSuppressFunc: suppressPolicySignedCertificateImportDiff,
+			},
+			"pem_encoded": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded certificate signed by certificate authority in response to the CSR",
+				Optional:         true,
+				ForceNew:         true,
+				ExactlyOneOf:     []string{"pem_encoded", "self_signed_days_valid"},
+				DiffSuppressFunc: suppressPolicySignedCertificateImportDiff,
+			},
+			"self_signed_days_valid": {
+				Type:             schema.TypeInt,
+				Description:      "Number of days the self-signed certificate will be valid",
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IntAtLeast(1),
+				DiffSuppressFunc: suppressPolicySignedCertificateImportDiff,
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Description: "Display name of the certificate",
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "Description of the certificate",
+				Computed:    true,
+			},
+			"certificate_type": {
+				Type:        schema.TypeString,
+				Description: "Certificate type as reported by NSX",
+				Computed:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the certificate",
+				Computed:    true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the certificate",
+				Computed:    true,
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Description: "Serial number of the certificate",
+				Computed:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeInt,
+				Description: "Start of the certificate validity, in epoch milliseconds",
+				Computed:    true,
+			},
+			"not_after": {
+				Type:        schema.TypeInt,
+				Description: "Expiration time of the certificate, in epoch milliseconds",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Arguments used to issue the certificate are not known for imported certificate,
+// hence they should not force replacement
+func suppressPolicySignedCertificateImportDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && (old == "" || old == "0")
+}
+
+func resourceNsxtPolicySignedCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	csrPath := d.Get("csr_path").(string)
+	csrID := getPolicyIDFromPath(csrPath)
+	client := infra.NewCsrsClient(connector)
+
+	var obj model.TlsCertificate
+	var err error
+	pemEncoded := d.Get("pem_encoded").(string)
+	if len(pemEncoded) > 0 {
+		// Certificate inherits display name and description from the CSR
+		var csr model.TlsCsr
+		csr, err = client.Get(csrID)
+		if err != nil {
+			return handleCreateError("Signed Certificate", csrID, err)
+		}
+		trustData := model.TlsTrustData{
+			DisplayName: csr.DisplayName,
+			Description: csr.Description,
+			Tags:        csr.Tags,
+			PemEncoded:  &pemEncoded,
+		}
+		log.Printf("[INFO] Importing signed certificate for CSR %s", csrID)
+		obj, err = client.Importcsr(csrID, trustData)
+		if err != nil {
+			return handleCreateError("Signed Certificate", csrID, err)
+		}
+	} else {
+		daysValid := int64(d.Get("self_signed_days_valid").(int))
+		log.Printf("[INFO] Self-signing certificate for CSR %s", csrID)
+		obj, err = client.Selfsign(csrID, daysValid)
+		if err != nil {
+			return handleCreateError("Self Signed Certificate", csrID, err)
+		}
+	}
+
+	if obj.Id == nil {
+		return fmt.Errorf("Failed to obtain certificate ID for CSR %s", csrID)
+	}
+
+	d.SetId(*obj.Id)
+
+	return resourceNsxtPolicySignedCertificateRead(d, m)
+}
+
+func resourceNsxtPolicySignedCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Signed Certificate ID")
+	}
+
+	client := infra.NewCertificatesClient(connector)
+	details := true
+	obj, err := client.Get(id, &details)
+	if err != nil {
+		return handleReadError(d, "Signed Certificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("certificate_type", obj.TlsCertificateType)
+	setPolicyCertificateDetailsInSchema(d, obj.Details)
+
+	return nil
+}
+
+func resourceNsxtPolicySignedCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Signed Certificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCertificatesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Signed Certificate", id, err)
+	}
+
+	return nil
+}
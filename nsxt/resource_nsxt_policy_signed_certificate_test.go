@@ -0,0 +1,125 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicySignedCertificate_selfSigned(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_signed_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySignedCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySignedCertificateSelfSignedTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySignedCertificateExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "self_signed_days_valid", "30"),
+					resource.TestCheckResourceAttrSet(testResourceName, "certificate_type"),
+					resource.TestCheckResourceAttrSet(testResourceName, "issuer"),
+					resource.TestCheckResourceAttrSet(testResourceName, "subject"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySignedCertificate_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_signed_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySignedCertificateCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySignedCertificateSelfSignedTemplate(name),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"csr_path", "pem_encoded", "self_signed_days_valid"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySignedCertificateExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Signed Certificate resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Signed Certificate resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCertificateExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Signed Certificate %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySignedCertificateCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_signed_certificate" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCertificateExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Signed Certificate %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySignedCertificateSelfSignedTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_certificate_signing_request" "test" {
+  display_name = "%s"
+  common_name  = "www.example.com"
+}
+
+resource "nsxt_policy_signed_certificate" "test" {
+  csr_path               = nsxt_policy_certificate_signing_request.test.path
+  self_signed_days_valid = 30
+}`, name)
+}
@@ -106,6 +106,35 @@ func getNsxtPolicyStaticRouteByID(sessionContext utl.SessionContext, connector c
 	return routeClient.Get(gwID, routeID)
 }
 
+func getPolicyStaticRouteNextHopsFromSchema(d *schema.ResourceData, network string) ([]model.RouterNexthop, error) {
+	var nextHopsStructs []model.RouterNexthop
+	nextHops := d.Get("next_hop").([]interface{})
+	for _, nextHop := range nextHops {
+		nextHopMap := nextHop.(map[string]interface{})
+		distance := int64(nextHopMap["admin_distance"].(int))
+		ip := nextHopMap["ip_address"].(string)
+		scope := nextHopMap["interface"].(string)
+		if err := validateStaticRouteNextHop(network, ip, scope); err != nil {
+			return nil, err
+		}
+		var scopeList []string
+		if scope != "" {
+			scopeList = append(scopeList, scope)
+		}
+		hopStruct := model.RouterNexthop{
+			AdminDistance: &distance,
+			Scope:         scopeList,
+		}
+
+		if len(ip) > 0 {
+			hopStruct.IpAddress = &ip
+		}
+		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	}
+
+	return nextHopsStructs, nil
+}
+
 func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{}) error {
 	connector := getPolicyConnector(m)
 
@@ -136,26 +165,9 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	tags := getPolicyTagsFromSchema(d)
 	network := d.Get("network").(string)
 
-	var nextHopsStructs []model.RouterNexthop
-	nextHops := d.Get("next_hop").([]interface{})
-	for _, nextHop := range nextHops {
-		nextHopMap := nextHop.(map[string]interface{})
-		distance := int64(nextHopMap["admin_distance"].(int))
-		ip := nextHopMap["ip_address"].(string)
-		scope := nextHopMap["interface"].(string)
-		var scopeList []string
-		if scope != "" {
-			scopeList = append(scopeList, scope)
-		}
-		hopStruct := model.RouterNexthop{
-			AdminDistance: &distance,
-			Scope:         scopeList,
-		}
-
-		if len(ip) > 0 {
-			hopStruct.IpAddress = &ip
-		}
-		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	nextHopsStructs, err := getPolicyStaticRouteNextHopsFromSchema(d, network)
+	if err != nil {
+		return err
 	}
 
 	routeStruct := model.StaticRoutes{
@@ -171,7 +183,7 @@ func resourceNsxtPolicyStaticRouteCreate(d *schema.ResourceData, m interface{})
 	}
 
 	log.Printf("[INFO] Creating Static Route with ID %s", id)
-	err := patchNsxtPolicyStaticRoute(getSessionContext(d, m), connector, gwID, routeStruct, isT0)
+	err = patchNsxtPolicyStaticRoute(getSessionContext(d, m), connector, gwID, routeStruct, isT0)
 	if err != nil {
 		return handleCreateError("Static Route", id, err)
 	}
@@ -263,25 +275,9 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	tags := getPolicyTagsFromSchema(d)
 	network := d.Get("network").(string)
 
-	var nextHopsStructs []model.RouterNexthop
-	nextHops := d.Get("next_hop").([]interface{})
-	for _, nextHop := range nextHops {
-		nextHopMap := nextHop.(map[string]interface{})
-		distance := int64(nextHopMap["admin_distance"].(int))
-		ip := nextHopMap["ip_address"].(string)
-		scope := nextHopMap["interface"].(string)
-		var scopeList []string
-		if scope != "" {
-			scopeList = append(scopeList, scope)
-		}
-		hopStruct := model.RouterNexthop{
-			AdminDistance: &distance,
-			Scope:         scopeList,
-		}
-		if len(ip) > 0 {
-			hopStruct.IpAddress = &ip
-		}
-		nextHopsStructs = append(nextHopsStructs, hopStruct)
+	nextHopsStructs, err := getPolicyStaticRouteNextHopsFromSchema(d, network)
+	if err != nil {
+		return err
 	}
 
 	routeStruct := model.StaticRoutes{
@@ -297,7 +293,7 @@ func resourceNsxtPolicyStaticRouteUpdate(d *schema.ResourceData, m interface{})
 	}
 
 	log.Printf("[INFO] Updating Static Route with ID %s", id)
-	err := patchNsxtPolicyStaticRoute(context, connector, gwID, routeStruct, isT0)
+	err = patchNsxtPolicyStaticRoute(context, connector, gwID, routeStruct, isT0)
 	if err != nil {
 		return handleUpdateError("Static Route", id, err)
 	}
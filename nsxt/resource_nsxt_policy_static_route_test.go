@@ -5,6 +5,7 @@ package nsxt
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -140,6 +141,41 @@ func testAccResourceNsxtPolicyStaticRouteBasicT1(t *testing.T, withContext bool,
 	})
 }
 
+func TestAccResourceNsxtPolicyStaticRoute_ipv6T1(t *testing.T) {
+	name := getAccTestResourceName()
+	network := "2001:db8:1::/64"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyStaticRouteCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyStaticRouteIPv6Tier1CreateTemplate(name, network, "9.10.10.1"),
+				ExpectError: regexp.MustCompile(`does not match address family`),
+			},
+			{
+				Config:      testAccNsxtPolicyStaticRouteIPv6Tier1CreateTemplate(name, network, "fe80::1"),
+				ExpectError: regexp.MustCompile(`interface must be specified`),
+			},
+			{
+				Config: testAccNsxtPolicyStaticRouteIPv6Tier1CreateTemplate(name, network, "2001:db8::1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyStaticRouteExists(testAccResourcePolicyStaticRouteName),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "display_name", name),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "network", network),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "next_hop.#", "1"),
+					resource.TestCheckResourceAttr(testAccResourcePolicyStaticRouteName, "next_hop.0.ip_address", "2001:db8::1"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyStaticRouteName, "path"),
+					resource.TestCheckResourceAttrSet(testAccResourcePolicyStaticRouteName, "revision"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyStaticRoute_basicT0Import(t *testing.T) {
 	name := getAccTestResourceName()
 	network := "14.1.1.0/24"
@@ -413,3 +449,23 @@ resource "nsxt_policy_static_route" "test" {
 }
 `, context, testAccResourcePolicyStaticRouteGatewayName, context, name, network)
 }
+
+func testAccNsxtPolicyStaticRouteIPv6Tier1CreateTemplate(name string, network string, nextHop string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "t1test" {
+  display_name              = "%s"
+  description               = "Acceptance Test"
+
+}
+
+resource "nsxt_policy_static_route" "test" {
+  display_name        = "%s"
+  description         = "Acceptance Test"
+  gateway_path        = "${nsxt_policy_tier1_gateway.t1test.path}"
+  network             = "%s"
+  next_hop {
+    ip_address = "%s"
+  }
+}
+`, testAccResourcePolicyStaticRouteGatewayName, name, network, nextHop)
+}
@@ -52,13 +52,15 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"nsx_id":        getNsxIDSchema(),
-			"path":          getPathSchema(),
-			"display_name":  getDisplayNameSchema(),
-			"description":   getDescriptionSchema(),
-			"revision":      getRevisionSchema(),
-			"tag":           getTagsSchema(),
-			"failover_mode": getFailoverModeSchema(failOverModeDefaultPolicyT0Value),
+			"nsx_id":               getNsxIDSchema(),
+			"path":                 getPathSchema(),
+			"display_name":         getDisplayNameSchema(),
+			"description":          getDescriptionSchema(),
+			"revision":             getRevisionSchema(),
+			"tag":                  getTagsSchema(),
+			"wait_for_realization": getPolicyWaitForRealizationSchema(),
+			"realization_timeout":  getPolicyRealizationTimeoutSchema(),
+			"failover_mode":        getFailoverModeSchema(failOverModeDefaultPolicyT0Value),
 			"default_rule_logging": {
 				Type:        schema.TypeBool,
 				Description: "Default rule logging",
@@ -128,6 +130,7 @@ func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 			"dhcp_config_path":       getPolicyPathSchema(false, false, "Policy path to DHCP server or relay configuration to use for this Tier0"),
 			"intersite_config":       getGatewayIntersiteConfigSchema(),
 			"redistribution_config":  getRedistributionConfigSchema(),
+			"multicast":              getPolicyTier0MulticastSchema(),
 			"rd_admin_address": {
 				Type:         schema.TypeString,
 				Description:  "Route distinguisher administrator address",
@@ -241,6 +244,33 @@ func getPolicyBGPConfigSchema() map[string]*schema.Schema {
 	}
 }
 
+func getPolicyTier0MulticastSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Multicast configuration for this Tier0 gateway",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Description: "Enable multicast routing on this gateway",
+					Optional:    true,
+					Default:     true,
+				},
+				"pim_profile_path":  getPolicyPathSchema(false, false, "Policy path to PIM profile"),
+				"igmp_profile_path": getPolicyPathSchema(false, false, "Policy path to IGMP profile"),
+				"replication_multicast_range": {
+					Type:         schema.TypeString,
+					Description:  "Multicast address range used for replication, in CIDR format",
+					Optional:     true,
+					ValidateFunc: validateIPCidr(),
+				},
+			},
+		},
+	}
+}
+
 func getVRFRouteSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:         schema.TypeString,
@@ -273,6 +303,47 @@ func getPolicyVRFConfigSchema() *schema.Schema {
 					Optional:    true,
 				},
 				"route_distinguisher": getVRFRouteSchema(),
+				"evpn_l2_vni_config": {
+					Type:        schema.TypeList,
+					Description: "EVPN L2 VNI configuration for the VRF, applicable in route server mode",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enable_vtep_groups": {
+								Type:        schema.TypeBool,
+								Description: "Enable VTEP groups for the L2 VNIs",
+								Optional:    true,
+								Default:     false,
+							},
+							"l2_vni": {
+								Type:        schema.TypeList,
+								Description: "L2 VNI configuration",
+								Required:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"vni": {
+											Type:        schema.TypeInt,
+											Description: "L2 VNI associated with the VRF",
+											Required:    true,
+										},
+										"route_distinguisher": getVRFRouteSchema(),
+										"import_targets": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     getVRFRouteElemSchema(),
+										},
+										"export_targets": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     getVRFRouteElemSchema(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 				"route_target": {
 					Type:        schema.TypeList,
 					Description: "Route targets",
@@ -436,6 +507,34 @@ func resourceNsxtPolicyTier0GatewayReadBGPConfig(d *schema.ResourceData, connect
 	return d.Set("bgp_config", bgpConfigs)
 }
 
+func resourceNsxtPolicyTier0GatewayReadMulticastConfig(d *schema.ResourceData, connector client.Connector, localeService model.LocaleServices) error {
+	var multicastConfigs []map[string]interface{}
+	client := locale_services.NewMulticastClient(connector)
+
+	multicastConfig, err := client.Get(d.Id(), *localeService.Id)
+	if err != nil {
+		if isNotFoundError(err) {
+			return d.Set("multicast", multicastConfigs)
+		}
+		return err
+	}
+
+	_, isSet := d.GetOk("multicast")
+	if !isSet && (multicastConfig.Enabled == nil || !*multicastConfig.Enabled) {
+		// Default disabled configuration, unless configured by the user
+		return d.Set("multicast", multicastConfigs)
+	}
+
+	cfgMap := make(map[string]interface{})
+	cfgMap["enabled"] = multicastConfig.Enabled
+	cfgMap["pim_profile_path"] = multicastConfig.PimProfilePath
+	cfgMap["igmp_profile_path"] = multicastConfig.IgmpProfilePath
+	cfgMap["replication_multicast_range"] = multicastConfig.ReplicationMulticastRange
+	multicastConfigs = append(multicastConfigs, cfgMap)
+
+	return d.Set("multicast", multicastConfigs)
+}
+
 func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig {
 
 	if nsxVersionLower("3.0.0") {
@@ -487,9 +586,153 @@ func getPolicyVRFConfigFromSchema(d *schema.ResourceData) *model.Tier0VrfConfig
 		config.EvpnTransitVni = &vni
 	}
 
+	config.EvpnL2VniConfig = getPolicyVRFEvpnL2VniConfigFromSchema(vrfConfig["evpn_l2_vni_config"].([]interface{}))
+
 	return &config
 }
 
+func getPolicyVRFEvpnL2VniConfigFromSchema(schemaConfig []interface{}) *model.VrfEvpnL2VniConfig {
+	if len(schemaConfig) == 0 || schemaConfig[0] == nil {
+		return nil
+	}
+
+	data := schemaConfig[0].(map[string]interface{})
+	enableVtepGroups := data["enable_vtep_groups"].(bool)
+	config := model.VrfEvpnL2VniConfig{
+		EnableVtepGroups: &enableVtepGroups,
+	}
+
+	addressFamily := model.VrfRouteTargets_ADDRESS_FAMILY_EVPN
+	for _, item := range data["l2_vni"].([]interface{}) {
+		vniData := item.(map[string]interface{})
+		vni := int64(vniData["vni"].(int))
+		routeDist := vniData["route_distinguisher"].(string)
+		exportTargets := interface2StringList(vniData["export_targets"].([]interface{}))
+		importTargets := interface2StringList(vniData["import_targets"].([]interface{}))
+
+		vniConfig := model.VrfL2VniConfig{
+			L2Vni: &vni,
+		}
+		if len(routeDist) > 0 {
+			vniConfig.RouteDistinguisher = &routeDist
+		}
+		if len(exportTargets)+len(importTargets) > 0 {
+			vniConfig.RouteTargets = []model.VrfRouteTargets{{
+				AddressFamily:      &addressFamily,
+				ExportRouteTargets: exportTargets,
+				ImportRouteTargets: importTargets,
+			}}
+		}
+		config.L2VniConfigs = append(config.L2VniConfigs, vniConfig)
+	}
+
+	return &config
+}
+
+func setPolicyVRFEvpnL2VniConfigInSchema(config *model.VrfEvpnL2VniConfig) []map[string]interface{} {
+	if config == nil {
+		return nil
+	}
+
+	elem := make(map[string]interface{})
+	elem["enable_vtep_groups"] = config.EnableVtepGroups
+	var vniList []map[string]interface{}
+	for _, vniConfig := range config.L2VniConfigs {
+		vniElem := make(map[string]interface{})
+		vniElem["vni"] = vniConfig.L2Vni
+		vniElem["route_distinguisher"] = vniConfig.RouteDistinguisher
+		if len(vniConfig.RouteTargets) > 0 {
+			vniElem["import_targets"] = vniConfig.RouteTargets[0].ImportRouteTargets
+			vniElem["export_targets"] = vniConfig.RouteTargets[0].ExportRouteTargets
+		}
+		vniList = append(vniList, vniElem)
+	}
+	elem["l2_vni"] = vniList
+
+	return []map[string]interface{}{elem}
+}
+
+// Collect route distinguishers and export route targets used by VRF config, including L2 VNIs
+func getPolicyVRFConfigRouteIdentifiers(config *model.Tier0VrfConfig) ([]string, []string) {
+	var distinguishers []string
+	var targets []string
+	if config.RouteDistinguisher != nil && *config.RouteDistinguisher != "" {
+		distinguishers = append(distinguishers, *config.RouteDistinguisher)
+	}
+	for _, routeTarget := range config.RouteTargets {
+		targets = append(targets, routeTarget.ExportRouteTargets...)
+	}
+	if config.EvpnL2VniConfig != nil {
+		for _, vniConfig := range config.EvpnL2VniConfig.L2VniConfigs {
+			if vniConfig.RouteDistinguisher != nil && *vniConfig.RouteDistinguisher != "" {
+				distinguishers = append(distinguishers, *vniConfig.RouteDistinguisher)
+			}
+			for _, routeTarget := range vniConfig.RouteTargets {
+				targets = append(targets, routeTarget.ExportRouteTargets...)
+			}
+		}
+	}
+
+	return distinguishers, targets
+}
+
+// Route distinguishers and export route targets must be unique across all VRFs of same parent Tier0
+func validatePolicyVRFConfigUniqueness(connector client.Connector, id string, config *model.Tier0VrfConfig) error {
+	distinguishers, targets := getPolicyVRFConfigRouteIdentifiers(config)
+	usedDistinguishers := make(map[string]string)
+	usedTargets := make(map[string]string)
+	for _, rd := range distinguishers {
+		if _, exists := usedDistinguishers[rd]; exists {
+			return fmt.Errorf("Route distinguisher %s is specified more than once in VRF %s", rd, id)
+		}
+		usedDistinguishers[rd] = id
+	}
+	for _, rt := range targets {
+		if _, exists := usedTargets[rt]; exists {
+			return fmt.Errorf("Export route target %s is specified more than once in VRF %s", rt, id)
+		}
+		usedTargets[rt] = id
+	}
+
+	if len(distinguishers)+len(targets) == 0 || config.Tier0Path == nil {
+		return nil
+	}
+
+	client := infra.NewTier0sClient(connector)
+	var cursor *string
+	for {
+		vrfList, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		for _, vrf := range vrfList.Results {
+			if vrf.Id == nil || *vrf.Id == id || vrf.VrfConfig == nil || vrf.VrfConfig.Tier0Path == nil {
+				continue
+			}
+			if *vrf.VrfConfig.Tier0Path != *config.Tier0Path {
+				continue
+			}
+			otherDistinguishers, otherTargets := getPolicyVRFConfigRouteIdentifiers(vrf.VrfConfig)
+			for _, rd := range otherDistinguishers {
+				if _, exists := usedDistinguishers[rd]; exists {
+					return fmt.Errorf("Route distinguisher %s is already used by VRF %s on %s", rd, *vrf.Id, *config.Tier0Path)
+				}
+			}
+			for _, rt := range otherTargets {
+				if _, exists := usedTargets[rt]; exists {
+					return fmt.Errorf("Export route target %s is already used by VRF %s on %s", rt, *vrf.Id, *config.Tier0Path)
+				}
+			}
+		}
+		cursor = vrfList.Cursor
+		if cursor == nil || len(*cursor) == 0 {
+			break
+		}
+	}
+
+	return nil
+}
+
 func setPolicyVRFConfigInSchema(d *schema.ResourceData, config *model.Tier0VrfConfig) error {
 	if config == nil {
 		return nil
@@ -511,6 +754,7 @@ func setPolicyVRFConfigInSchema(d *schema.ResourceData, config *model.Tier0VrfCo
 		routeTargets = append(routeTargets, routeTarget)
 		elem["route_target"] = routeTargets
 	}
+	elem["evpn_l2_vni_config"] = setPolicyVRFEvpnL2VniConfigInSchema(config.EvpnL2VniConfig)
 
 	vrfConfigs = append(vrfConfigs, elem)
 
@@ -693,9 +937,24 @@ func verifyPolicyTier0GatewayConfig(d *schema.ResourceData, isGlobalManager bool
 		if !isSetLocaleService {
 			return fmt.Errorf("locale_service setting is mandatory with NSX Global Manager")
 		}
+
+		_, isSet = d.GetOk("multicast")
+		if isSet {
+			return fmt.Errorf("multicast setting is not supported with NSX Global Manager")
+		}
 		return nil
 	}
 
+	_, isSetMulticast := d.GetOk("multicast")
+	if isSetMulticast {
+		if isSetLocaleService {
+			return fmt.Errorf("multicast setting is only supported with edge_cluster_path, not with locale_service")
+		}
+		if nsxVersionLower("3.0.0") {
+			return fmt.Errorf("multicast setting requires NSX version 3.0.0 or higher")
+		}
+	}
+
 	return nil
 }
 
@@ -713,6 +972,51 @@ func initPolicyTier0ChildBgpConfig(config *model.BgpRoutingConfig) (*data.Struct
 	return dataValue.(*data.StructValue), nil
 }
 
+func initPolicyTier0ChildMulticastConfig(d *schema.ResourceData) (*data.StructValue, error) {
+	// When multicast block is removed, multicast is disabled on the gateway
+	enabled := false
+	id := "multicast"
+	resourceType := "PolicyMulticastConfig"
+	config := model.PolicyMulticastConfig{
+		Id:           &id,
+		ResourceType: &resourceType,
+		Enabled:      &enabled,
+	}
+
+	multicastConfigs := d.Get("multicast").([]interface{})
+	if len(multicastConfigs) > 0 && multicastConfigs[0] != nil {
+		cfgMap := multicastConfigs[0].(map[string]interface{})
+		enabled = cfgMap["enabled"].(bool)
+		pimProfilePath := cfgMap["pim_profile_path"].(string)
+		igmpProfilePath := cfgMap["igmp_profile_path"].(string)
+		replicationRange := cfgMap["replication_multicast_range"].(string)
+		if enabled && len(replicationRange) == 0 {
+			return nil, fmt.Errorf("replication_multicast_range is required when multicast is enabled")
+		}
+		if len(pimProfilePath) > 0 {
+			config.PimProfilePath = &pimProfilePath
+		}
+		if len(igmpProfilePath) > 0 {
+			config.IgmpProfilePath = &igmpProfilePath
+		}
+		if len(replicationRange) > 0 {
+			config.ReplicationMulticastRange = &replicationRange
+		}
+	}
+
+	converter := bindings.NewTypeConverter()
+	childConfig := model.ChildPolicyMulticastConfig{
+		ResourceType:          "ChildPolicyMulticastConfig",
+		PolicyMulticastConfig: &config,
+	}
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyMulticastConfigBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
 func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *schema.ResourceData, connector client.Connector, id string) (model.Infra, error) {
 	var infraChildren, gwChildren, lsChildren []*data.StructValue
 	var infraStruct model.Infra
@@ -733,6 +1037,12 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 	vrfTransitSubnets := interfaceListToStringList(d.Get("vrf_transit_subnets").([]interface{}))
 	ipv6ProfilePaths := getIpv6ProfilePathsFromSchema(d)
 	vrfConfig := getPolicyVRFConfigFromSchema(d)
+	if vrfConfig != nil && context.ClientType == utl.Local {
+		err := validatePolicyVRFConfigUniqueness(connector, id, vrfConfig)
+		if err != nil {
+			return infraStruct, err
+		}
+	}
 	dhcpPath := d.Get("dhcp_config_path").(string)
 	rdAdminAddress := d.Get("rd_admin_address").(string)
 	rdAdminField := &rdAdminAddress
@@ -793,6 +1103,15 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		lsChildren = append(lsChildren, structValue)
 	}
 
+	multicastConfig := d.Get("multicast").([]interface{})
+	if (len(multicastConfig) > 0 || d.HasChange("multicast")) && !isGlobalManager {
+		structValue, err := initPolicyTier0ChildMulticastConfig(d)
+		if err != nil {
+			return infraStruct, err
+		}
+		lsChildren = append(lsChildren, structValue)
+	}
+
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	_, redistributionSet := d.GetOk("redistribution_config")
 	// The user can either define locale_service (GL or LM) or edge_cluster_path (LM only)
@@ -817,6 +1136,12 @@ func policyTier0GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 					return infraStruct, fmt.Errorf("A valid edge_cluster_path is required when BGP is enabled")
 				}
 			}
+			if d.Get("edge_cluster_path") == "" && (len(multicastConfig) > 0) {
+				multicastMap := multicastConfig[0].(map[string]interface{})
+				if multicastMap["enabled"].(bool) {
+					return infraStruct, fmt.Errorf("A valid edge_cluster_path is required when multicast is enabled")
+				}
+			}
 
 			var err error
 			dataValue, err := initSingleTier0GatewayLocaleService(context, d, lsChildren, connector)
@@ -878,7 +1203,12 @@ func resourceNsxtPolicyTier0GatewayCreate(d *schema.ResourceData, m interface{})
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	err = resourceNsxtPolicyTier0GatewayRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier0", id)
 }
 
 func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) error {
@@ -989,6 +1319,13 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 						return handleReadError(d, "BGP Configuration for T0", id, err)
 					}
 
+					if nsxVersionHigherOrEqual("3.0.0") {
+						err = resourceNsxtPolicyTier0GatewayReadMulticastConfig(d, connector, service)
+						if err != nil {
+							return handleReadError(d, "Multicast Configuration for T0", id, err)
+						}
+					}
+
 					redistributionConfigs := getLocaleServiceRedistributionConfig(&localeServices[i])
 					if d.Get("redistribution_set").(bool) {
 						d.Set("redistribution_config", redistributionConfigs)
@@ -1004,6 +1341,7 @@ func resourceNsxtPolicyTier0GatewayRead(d *schema.ResourceData, m interface{}) e
 	} else {
 		// set empty bgp_config to keep empty plan
 		d.Set("bgp_config", make([]map[string]interface{}, 0))
+		d.Set("multicast", make([]map[string]interface{}, 0))
 	}
 
 	if shouldSetLS {
@@ -1050,7 +1388,12 @@ func resourceNsxtPolicyTier0GatewayUpdate(d *schema.ResourceData, m interface{})
 		return handleUpdateError("Tier0", id, err)
 	}
 
-	return resourceNsxtPolicyTier0GatewayRead(d, m)
+	err = resourceNsxtPolicyTier0GatewayRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier0", id)
 }
 
 func resourceNsxtPolicyTier0GatewayDelete(d *schema.ResourceData, m interface{}) error {
@@ -47,6 +47,8 @@ func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 			"description":            getDescriptionSchema(),
 			"revision":               getRevisionSchema(),
 			"tag":                    getTagsSchema(),
+			"wait_for_realization":   getPolicyWaitForRealizationSchema(),
+			"realization_timeout":    getPolicyRealizationTimeoutSchema(),
 			"gateway_path":           getPolicyPathSchema(true, true, "Policy path for Tier0 gateway"),
 			"segment_path":           getPolicyPathSchema(false, true, "Policy path for connected segment"),
 			"subnets":                getGatewayInterfaceSubnetsSchema(),
@@ -355,7 +357,12 @@ func resourceNsxtPolicyTier0GatewayInterfaceCreate(d *schema.ResourceData, m int
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	err = resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier0 Interface", id)
 }
 
 func resourceNsxtPolicyTier0GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
@@ -519,7 +526,12 @@ func resourceNsxtPolicyTier0GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		return handleUpdateError("Tier0 Interface", id, err)
 	}
 
-	return resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	err = resourceNsxtPolicyTier0GatewayInterfaceRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier0 Interface", id)
 }
 
 func resourceNsxtPolicyTier0GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
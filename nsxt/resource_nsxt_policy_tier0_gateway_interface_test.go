@@ -464,6 +464,33 @@ func TestAccResourceNsxtPolicyTier0GatewayInterface_withV6(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier0GatewayInterface_waitForRealization(t *testing.T) {
+	name := getAccTestResourceName()
+	subnet := "1.1.12.2/24"
+	testResourceName := "nsxt_policy_tier0_gateway_interface.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0InterfaceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier0InterfaceWaitForRealizationTemplate(name, subnet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier0InterfaceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "wait_for_realization", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "realization_timeout", "300"),
+					resource.TestCheckResourceAttr(testResourceName, "subnets.0", subnet),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNSXPolicyTier0InterfaceImporterGetID(s *terraform.State) (string, error) {
 	testResourceName := "nsxt_policy_tier0_gateway_interface.test"
 	rs, ok := s.RootModule().Resources[testResourceName]
@@ -745,6 +772,26 @@ resource "nsxt_policy_tier0_gateway_interface" "test" {
 		testAccNsxtPolicyTier0InterfaceRealizationTemplate()
 }
 
+func testAccNsxtPolicyTier0InterfaceWaitForRealizationTemplate(name string, subnet string) string {
+	return testAccNsxtPolicyGatewayInterfaceDeps("11", false) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name         = "%s"
+  ha_mode              = "ACTIVE_STANDBY"
+  wait_for_realization = true
+  %s
+}
+
+resource "nsxt_policy_tier0_gateway_interface" "test" {
+  display_name         = "%s"
+  type                 = "SERVICE"
+  gateway_path         = nsxt_policy_tier0_gateway.test.path
+  segment_path         = nsxt_policy_vlan_segment.test.path
+  subnets              = ["%s"]
+  wait_for_realization = true
+  realization_timeout  = 300
+}`, nsxtPolicyTier0GatewayName, testAccNsxtPolicyTier0EdgeClusterTemplate(), name, subnet)
+}
+
 func testAccNsxtPolicyTier0InterfaceTemplateWithV6(name string, subnet string) string {
 	return testAccNsxtPolicyGatewayInterfaceDeps("11", false) + fmt.Sprintf(`
 data "nsxt_policy_ipv6_ndra_profile" "default" {
@@ -482,6 +482,24 @@ func TestAccResourceNsxtPolicyTier0Gateway_withVRF(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier0Gateway_withVRFDuplicateTargets(t *testing.T) {
+	name := getAccTestResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t); testAccNSXVersion(t, "3.0.0") },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier0CheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccNsxtPolicyTier0WithDuplicateVRFTargetsTemplate(name),
+				ExpectError: regexp.MustCompile("Export route target 2:14 is already used"),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyTier0Gateway_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_tier0_gateway.test"
@@ -913,6 +931,42 @@ data "nsxt_policy_realization_info" "realization_info" {
 }`, name, routeTargets, rdAdminAddress, name, bgpConfig)
 }
 
+func testAccNsxtPolicyTier0WithDuplicateVRFTargetsTemplate(name string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + fmt.Sprintf(`
+resource "nsxt_policy_tier0_gateway" "parent" {
+  display_name      = "parent"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_tier0_gateway" "other" {
+  display_name      = "%s-other"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+    route_target {
+      auto_mode      = false
+      import_targets = ["2:12"]
+      export_targets = ["2:14"]
+    }
+  }
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+  vrf_config {
+    gateway_path = nsxt_policy_tier0_gateway.parent.path
+    route_target {
+      auto_mode      = false
+      import_targets = ["2:14"]
+      export_targets = ["2:14"]
+    }
+  }
+
+  depends_on = [nsxt_policy_tier0_gateway.other]
+}`, name, name)
+}
+
 func testAccNsxtPolicyTier0WithVRFTearDown() string {
 	return testAccNsxtPolicyGatewayInterfaceDeps("11, 12", false) + `
 data "nsxt_policy_edge_node" "EN" {
@@ -1000,3 +1054,35 @@ data "nsxt_policy_realization_info" "realization_info" {
   path = nsxt_policy_tier0_gateway.test.path
 }`, name)
 }
+
+func testAccNsxtPolicyTier0WithMulticastTemplate(name string, edgeClusterName string, withMulticast bool) string {
+	multicast := ""
+	if withMulticast {
+		multicast = `
+  multicast {
+    pim_profile_path            = nsxt_policy_pim_profile.test.path
+    igmp_profile_path           = nsxt_policy_igmp_profile.test.path
+    replication_multicast_range = "233.1.0.0/16"
+  }`
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "EC" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+  rp_address   = "10.10.10.1"
+}
+
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  ha_mode           = "ACTIVE_STANDBY"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+%s
+}`, edgeClusterName, name, name, name, multicast)
+}
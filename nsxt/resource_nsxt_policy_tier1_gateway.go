@@ -12,6 +12,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	t1_locale_service "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
@@ -65,15 +66,17 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"nsx_id":            getNsxIDSchema(),
-			"path":              getPathSchema(),
-			"display_name":      getDisplayNameSchema(),
-			"description":       getDescriptionSchema(),
-			"revision":          getRevisionSchema(),
-			"tag":               getTagsSchema(),
-			"edge_cluster_path": getPolicyEdgeClusterPathSchema(),
-			"locale_service":    getPolicyLocaleServiceSchema(true),
-			"failover_mode":     getFailoverModeSchema(failOverModeDefaultValue),
+			"nsx_id":               getNsxIDSchema(),
+			"path":                 getPathSchema(),
+			"display_name":         getDisplayNameSchema(),
+			"description":          getDescriptionSchema(),
+			"revision":             getRevisionSchema(),
+			"tag":                  getTagsSchema(),
+			"wait_for_realization": getPolicyWaitForRealizationSchema(),
+			"realization_timeout":  getPolicyRealizationTimeoutSchema(),
+			"edge_cluster_path":    getPolicyEdgeClusterPathSchema(),
+			"locale_service":       getPolicyLocaleServiceSchema(true),
+			"failover_mode":        getFailoverModeSchema(failOverModeDefaultValue),
 			"default_rule_logging": {
 				Type:        schema.TypeBool,
 				Description: "Default rule logging",
@@ -143,6 +146,22 @@ func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(t1TypeValues, false),
 				Optional:     true,
 			},
+			"multicast": {
+				Type:        schema.TypeList,
+				Description: "Multicast configuration for this Tier1 gateway",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Enable multicast routing on this gateway",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
 			"context": getContextSchema(),
 		},
 	}
@@ -165,7 +184,7 @@ func getAdvRulesSchema() *schema.Schema {
 					Description: "List of network CIDRs to be routed",
 					Elem: &schema.Schema{
 						Type:         schema.TypeString,
-						ValidateFunc: validateCidr(),
+						ValidateFunc: validateRoutableCidr(),
 					},
 					Required: true,
 				},
@@ -253,6 +272,61 @@ func resourceNsxtPolicyTier1GatewayReadEdgeCluster(context utl.SessionContext, d
 	return nil
 }
 
+func resourceNsxtPolicyTier1GatewayReadMulticastConfig(d *schema.ResourceData, connector client.Connector, localeServiceID string) error {
+	var multicastConfigs []map[string]interface{}
+	client := t1_locale_service.NewMulticastClient(connector)
+
+	multicastConfig, err := client.Get(d.Id(), localeServiceID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return d.Set("multicast", multicastConfigs)
+		}
+		return err
+	}
+
+	_, isSet := d.GetOk("multicast")
+	if !isSet && (multicastConfig.Enabled == nil || !*multicastConfig.Enabled) {
+		// Default disabled configuration, unless configured by the user
+		return d.Set("multicast", multicastConfigs)
+	}
+
+	cfgMap := make(map[string]interface{})
+	cfgMap["enabled"] = multicastConfig.Enabled
+	multicastConfigs = append(multicastConfigs, cfgMap)
+
+	return d.Set("multicast", multicastConfigs)
+}
+
+func initPolicyTier1ChildMulticastConfig(d *schema.ResourceData) (*data.StructValue, error) {
+	// When multicast block is removed, multicast is disabled on the gateway
+	enabled := false
+	multicastConfigs := d.Get("multicast").([]interface{})
+	if len(multicastConfigs) > 0 && multicastConfigs[0] != nil {
+		cfgMap := multicastConfigs[0].(map[string]interface{})
+		enabled = cfgMap["enabled"].(bool)
+	}
+
+	id := "multicast"
+	resourceType := "PolicyTier1MulticastConfig"
+	config := model.PolicyTier1MulticastConfig{
+		Id:           &id,
+		ResourceType: &resourceType,
+		Enabled:      &enabled,
+	}
+
+	converter := bindings.NewTypeConverter()
+	childConfig := model.ChildPolicyTier1MulticastConfig{
+		ResourceType:               "ChildPolicyTier1MulticastConfig",
+		PolicyTier1MulticastConfig: &config,
+	}
+	dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPolicyTier1MulticastConfigBindingType())
+	if errors != nil {
+		return nil, fmt.Errorf("Error converting child Multicast Configuration: %v", errors[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
 func resourceNsxtPolicyTier1GatewayExists(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
 	client := infra.NewTier1sClient(context, connector)
 	_, err := client.Get(id)
@@ -330,7 +404,7 @@ func resourceNsxtPolicyTier1GatewaySetVersionDependentAttrs(d *schema.ResourceDa
 
 }
 
-func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, connector client.Connector) (*data.StructValue, error) {
+func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.ResourceData, children []*data.StructValue, connector client.Connector) (*data.StructValue, error) {
 
 	edgeClusterPath := d.Get("edge_cluster_path").(string)
 	var serviceStruct *model.LocaleServices
@@ -355,6 +429,9 @@ func initSingleTier1GatewayLocaleService(context utl.SessionContext, d *schema.R
 	} else {
 		serviceStruct.EdgeClusterPath = nil
 	}
+	if len(children) > 0 {
+		serviceStruct.Children = children
+	}
 
 	log.Printf("[DEBUG] Using Locale Service with ID %s and Edge Cluster %v", *serviceStruct.Id, serviceStruct.EdgeClusterPath)
 	return initChildLocaleService(serviceStruct, false)
@@ -431,9 +508,34 @@ func policyTier1GatewayResourceToInfraStruct(context utl.SessionContext, d *sche
 		obj.IntersiteConfig = intersiteConfig
 	}
 
+	var lsChildren []*data.StructValue
+	multicastConfig := d.Get("multicast").([]interface{})
+	if len(multicastConfig) > 0 || d.HasChange("multicast") {
+		if context.ClientType != utl.Local {
+			return infraStruct, fmt.Errorf("multicast setting is only supported with NSX Local Manager")
+		}
+		if _, isSet := d.GetOk("locale_service"); isSet {
+			return infraStruct, fmt.Errorf("multicast setting is only supported with edge_cluster_path, not with locale_service")
+		}
+		if len(multicastConfig) > 0 && nsxVersionLower("4.1.0") {
+			return infraStruct, fmt.Errorf("multicast setting requires NSX version 4.1.0 or higher")
+		}
+		if d.Get("edge_cluster_path").(string) == "" && len(multicastConfig) > 0 {
+			multicastMap := multicastConfig[0].(map[string]interface{})
+			if multicastMap["enabled"].(bool) {
+				return infraStruct, fmt.Errorf("A valid edge_cluster_path is required when multicast is enabled")
+			}
+		}
+		structValue, err := initPolicyTier1ChildMulticastConfig(d)
+		if err != nil {
+			return infraStruct, err
+		}
+		lsChildren = append(lsChildren, structValue)
+	}
+
 	// set edge cluster for local manager if needed
-	if d.HasChange("edge_cluster_path") && context.ClientType != utl.Global {
-		dataValue, err := initSingleTier1GatewayLocaleService(context, d, connector)
+	if (d.HasChange("edge_cluster_path") || len(lsChildren) > 0) && context.ClientType != utl.Global {
+		dataValue, err := initSingleTier1GatewayLocaleService(context, d, lsChildren, connector)
 		if err != nil {
 			return infraStruct, err
 		}
@@ -517,7 +619,12 @@ func resourceNsxtPolicyTier1GatewayCreate(d *schema.ResourceData, m interface{})
 	d.SetId(id)
 	d.Set("nsx_id", id)
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	err = resourceNsxtPolicyTier1GatewayRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier1", id)
 }
 
 func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) error {
@@ -611,6 +718,12 @@ func resourceNsxtPolicyTier1GatewayRead(d *schema.ResourceData, m interface{}) e
 			} else {
 				if service.EdgeClusterPath != nil {
 					d.Set("edge_cluster_path", service.EdgeClusterPath)
+					if context.ClientType == utl.Local && nsxVersionHigherOrEqual("4.1.0") {
+						err = resourceNsxtPolicyTier1GatewayReadMulticastConfig(d, connector, *service.Id)
+						if err != nil {
+							return handleReadError(d, "Multicast Configuration for T1", id, err)
+						}
+					}
 				}
 			}
 		}
@@ -662,7 +775,12 @@ func resourceNsxtPolicyTier1GatewayUpdate(d *schema.ResourceData, m interface{})
 		return handleUpdateError("Tier1", id, err)
 	}
 
-	return resourceNsxtPolicyTier1GatewayRead(d, m)
+	err = resourceNsxtPolicyTier1GatewayRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier1", id)
 }
 
 func resourceNsxtPolicyTier1GatewayDelete(d *schema.ResourceData, m interface{}) error {
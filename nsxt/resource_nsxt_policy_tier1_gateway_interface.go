@@ -37,6 +37,8 @@ func resourceNsxtPolicyTier1GatewayInterface() *schema.Resource {
 			"description":            getDescriptionSchema(),
 			"revision":               getRevisionSchema(),
 			"tag":                    getTagsSchema(),
+			"wait_for_realization":   getPolicyWaitForRealizationSchema(),
+			"realization_timeout":    getPolicyRealizationTimeoutSchema(),
 			"context":                getContextSchema(),
 			"gateway_path":           getPolicyPathSchema(true, true, "Policy path for tier1 gateway"),
 			"segment_path":           getPolicyPathSchema(true, true, "Policy path for connected segment"),
@@ -171,7 +173,12 @@ func resourceNsxtPolicyTier1GatewayInterfaceCreate(d *schema.ResourceData, m int
 	d.Set("nsx_id", id)
 	d.Set("locale_service_id", localeServiceID)
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	err = resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier1 Interface", id)
 }
 
 func resourceNsxtPolicyTier1GatewayInterfaceRead(d *schema.ResourceData, m interface{}) error {
@@ -290,7 +297,12 @@ func resourceNsxtPolicyTier1GatewayInterfaceUpdate(d *schema.ResourceData, m int
 		return handleUpdateError("Tier1 Interface", id, err)
 	}
 
-	return resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	err = resourceNsxtPolicyTier1GatewayInterfaceRead(d, m)
+	if err != nil {
+		return err
+	}
+
+	return policyGatewayWaitForRealization(d, m, "Tier1 Interface", id)
 }
 
 func resourceNsxtPolicyTier1GatewayInterfaceDelete(d *schema.ResourceData, m interface{}) error {
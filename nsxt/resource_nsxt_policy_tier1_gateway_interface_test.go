@@ -293,6 +293,32 @@ func TestAccResourceNsxtPolicyTier1GatewayInterface_withIPv6(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier1GatewayInterface_waitForRealization(t *testing.T) {
+	name := getAccTestResourceName()
+	subnet := "1.1.12.2/24"
+	testResourceName := "nsxt_policy_tier1_gateway_interface.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier1InterfaceCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1InterfaceWaitForRealizationTemplate(name, subnet),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1InterfaceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "wait_for_realization", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "subnets.0", subnet),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+		},
+	})
+}
+
 func testAccNSXPolicyTier1InterfaceImporterGetID(s *terraform.State) (string, error) {
 	testResourceName := "nsxt_policy_tier1_gateway_interface.test"
 	rs, ok := s.RootModule().Resources[testResourceName]
@@ -494,6 +520,23 @@ func testAccNextPolicyTier1InterfaceRealizationTemplate() string {
 	return strings.Replace(testAccNsxtPolicyTier0InterfaceRealizationTemplate(), "tier0", "tier1", -1)
 }
 
+func testAccNsxtPolicyTier1InterfaceWaitForRealizationTemplate(name string, subnet string) string {
+	return testAccNsxtPolicyGatewayInterfaceDeps("11", false) + fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name         = "%s"
+  wait_for_realization = true
+  %s
+}
+
+resource "nsxt_policy_tier1_gateway_interface" "test" {
+  display_name         = "%s"
+  gateway_path         = nsxt_policy_tier1_gateway.test.path
+  segment_path         = nsxt_policy_vlan_segment.test.path
+  subnets              = ["%s"]
+  wait_for_realization = true
+}`, nsxtPolicyTier1GatewayName, testAccNsxtPolicyTier0EdgeClusterTemplate(), name, subnet)
+}
+
 func testAccNsxtPolicyTier1InterfaceTemplateWithIPv6(name string, subnet string) string {
 	return testAccNsxtPolicyGatewayInterfaceDeps("11", false) + fmt.Sprintf(`
 data "nsxt_policy_ipv6_ndra_profile" "default" {
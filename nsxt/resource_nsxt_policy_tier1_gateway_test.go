@@ -269,6 +269,41 @@ func TestAccResourceNsxtPolicyTier1Gateway_withEdgeCluster(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyTier1Gateway_withMulticast(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tier1_gateway.test"
+	edgeClusterName := getEdgeClusterName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+			testAccNSXVersion(t, "4.1.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTier1CheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTier1WithMulticastTemplate(name, edgeClusterName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "multicast.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "multicast.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTier1WithMulticastTemplate(name, edgeClusterName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTier1Exists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "multicast.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyTier1Gateway_withId(t *testing.T) {
 	name := getAccTestResourceName()
 	id := "test-id"
@@ -829,3 +864,21 @@ resource "nsxt_policy_tier1_gateway" "test" {
   display_name             = "%s"
 }`, profileName, name)
 }
+
+func testAccNsxtPolicyTier1WithMulticastTemplate(name string, edgeClusterName string, withMulticast bool) string {
+	multicast := ""
+	if withMulticast {
+		multicast = `
+  multicast {
+    enabled = true
+  }`
+	}
+	return testAccNsxtPolicyTier0WithMulticastTemplate(name, edgeClusterName, true) + fmt.Sprintf(`
+
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.EC.path
+  tier0_path        = nsxt_policy_tier0_gateway.test.path
+%s
+}`, name, multicast)
+}
@@ -0,0 +1,210 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var tlsInspectionInvalidCertActionValues = []string{
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_BLOCK,
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_ALLOW,
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfile() *schema.Resource {
+	profileSchema := getTLSInspectionProfileCommonSchema()
+	profileSchema["invalid_certificate_action"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Action to take when server presents an invalid certificate",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(tlsInspectionInvalidCertActionValues, false),
+		Default:      model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_BLOCK,
+	}
+	profileSchema["trusted_ca_certificate_path"] = getPolicyPathSchema(true, false, "Proxy CA certificate and key used to issue certificates for trusted servers")
+	profileSchema["untrusted_ca_certificate_path"] = getPolicyPathSchema(false, false, "Proxy CA certificate and key used to issue certificates for untrusted servers")
+	// External profile always requires CA bundle and CRL
+	profileSchema["trusted_ca_bundle_paths"].Optional = false
+	profileSchema["trusted_ca_bundle_paths"].Required = true
+	profileSchema["crl_paths"].Optional = false
+	profileSchema["crl_paths"].Required = true
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionExternalProfileCreate,
+		Read:   resourceNsxtPolicyTLSInspectionExternalProfileRead,
+		Update: resourceNsxtPolicyTLSInspectionExternalProfileUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionExternalProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: profileSchema,
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyTLSInspectionProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	invalidCertAction := d.Get("invalid_certificate_action").(string)
+	proxyTrustedCaCert := d.Get("trusted_ca_certificate_path").(string)
+	proxyUntrustedCaCert := d.Get("untrusted_ca_certificate_path").(string)
+	cryptoEnforcement := d.Get("crypto_enforcement").(string)
+	decryptionFailAction := d.Get("decryption_fail_action").(string)
+	ocspMustStaple := d.Get("ocsp_must_staple").(bool)
+	tlsConfigSetting := d.Get("tls_config_setting").(string)
+	clientMaxTLSVersion := d.Get("client_max_tls_version").(string)
+	clientMinTLSVersion := d.Get("client_min_tls_version").(string)
+	serverMaxTLSVersion := d.Get("server_max_tls_version").(string)
+	serverMinTLSVersion := d.Get("server_min_tls_version").(string)
+	idleConnectionTimeout := int64(d.Get("idle_connection_timeout").(int))
+
+	obj := model.TlsInspectionExternalProfile{
+		DisplayName:          &displayName,
+		Description:          &description,
+		Tags:                 tags,
+		InvalidCertAction:    &invalidCertAction,
+		ProxyTrustedCaCert:   &proxyTrustedCaCert,
+		ClientCipherSuite:    getStringListFromSchemaSet(d, "client_cipher_suites"),
+		ServerCipherSuite:    getStringListFromSchemaSet(d, "server_cipher_suites"),
+		CryptoEnforcement:    &cryptoEnforcement,
+		DecryptionFailAction: &decryptionFailAction,
+		OcspMustStaple:       &ocspMustStaple,
+		TlsConfigSetting:     &tlsConfigSetting,
+		Crls:                 getStringListFromSchemaSet(d, "crl_paths"),
+		TrustedCaBundles:     getStringListFromSchemaSet(d, "trusted_ca_bundle_paths"),
+		ResourceType:         model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONEXTERNALPROFILE,
+	}
+
+	if len(proxyUntrustedCaCert) > 0 {
+		obj.ProxyUntrustedCaCert = &proxyUntrustedCaCert
+	}
+	if len(clientMaxTLSVersion) > 0 {
+		obj.ClientMaxTlsVersion = &clientMaxTLSVersion
+	}
+	if len(clientMinTLSVersion) > 0 {
+		obj.ClientMinTlsVersion = &clientMinTLSVersion
+	}
+	if len(serverMaxTLSVersion) > 0 {
+		obj.ServerMaxTlsVersion = &serverMaxTLSVersion
+	}
+	if len(serverMinTLSVersion) > 0 {
+		obj.ServerMinTlsVersion = &serverMinTLSVersion
+	}
+	if idleConnectionTimeout > 0 {
+		obj.IdleConnectionTimeout = &idleConnectionTimeout
+	}
+
+	log.Printf("[INFO] Patching TlsInspectionExternalProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.TlsInspectionExternalProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting TlsInspectionExternalProfile %s", errs[0])
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Patch(id, dataValue.(*data.StructValue))
+	return err
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionExternalProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyTLSInspectionExternalProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("TlsInspectionExternalProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionExternalProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TlsInspectionExternalProfile ID")
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TlsInspectionExternalProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.TlsInspectionExternalProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("TLS Inspection Profile with id %s is not of type TlsInspectionExternalProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.TlsInspectionExternalProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("invalid_certificate_action", profile.InvalidCertAction)
+	d.Set("trusted_ca_certificate_path", profile.ProxyTrustedCaCert)
+	d.Set("untrusted_ca_certificate_path", profile.ProxyUntrustedCaCert)
+	d.Set("client_cipher_suites", profile.ClientCipherSuite)
+	d.Set("client_max_tls_version", profile.ClientMaxTlsVersion)
+	d.Set("client_min_tls_version", profile.ClientMinTlsVersion)
+	d.Set("server_cipher_suites", profile.ServerCipherSuite)
+	d.Set("server_max_tls_version", profile.ServerMaxTlsVersion)
+	d.Set("server_min_tls_version", profile.ServerMinTlsVersion)
+	d.Set("crypto_enforcement", profile.CryptoEnforcement)
+	d.Set("decryption_fail_action", profile.DecryptionFailAction)
+	d.Set("ocsp_must_staple", profile.OcspMustStaple)
+	d.Set("tls_config_setting", profile.TlsConfigSetting)
+	d.Set("crl_paths", profile.Crls)
+	d.Set("trusted_ca_bundle_paths", profile.TrustedCaBundles)
+	d.Set("idle_connection_timeout", profile.IdleConnectionTimeout)
+	d.Set("attention", profile.Attention)
+
+	return nil
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TlsInspectionExternalProfile ID")
+	}
+
+	err := resourceNsxtPolicyTLSInspectionExternalProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("TlsInspectionExternalProfile", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionExternalProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionExternalProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyTLSInspectionProfileDelete(d, m)
+}
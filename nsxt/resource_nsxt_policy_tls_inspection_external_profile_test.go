@@ -0,0 +1,248 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyTLSInspectionExternalProfileCreateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform created",
+	"invalid_certificate_action": "BLOCK",
+	"decryption_fail_action":     "BLOCK",
+	"crypto_enforcement":         "ENFORCE",
+	"tls_config_setting":         "CUSTOM",
+	"client_cipher_suites":       "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"server_cipher_suites":       "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"server_min_tls_version":     "TLS_V1_1",
+	"server_max_tls_version":     "TLS_V1_2",
+	"ocsp_must_staple":           "true",
+}
+
+var accTestPolicyTLSInspectionExternalProfileUpdateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform updated",
+	"invalid_certificate_action": "ALLOW",
+	"decryption_fail_action":     "BYPASS",
+	"crypto_enforcement":         "TRANSPARENT",
+	"tls_config_setting":         "CUSTOM",
+	"client_cipher_suites":       "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"server_cipher_suites":       "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"server_min_tls_version":     "TLS_V1_2",
+	"server_max_tls_version":     "TLS_V1_2",
+	"ocsp_must_staple":           "false",
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfilePreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccNSXVersion(t, "3.2.0")
+	testAccEnvDefined(t, "NSXT_TEST_CA_CERTIFICATE_NAME")
+	testAccEnvDefined(t, "NSXT_TEST_CA_BUNDLE_NAME")
+	testAccEnvDefined(t, "NSXT_TEST_CRL_PATH")
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionExternalProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_inspection_external_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyTLSInspectionExternalProfilePreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionExternalProfileCheckDestroy(state, accTestPolicyTLSInspectionExternalProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionExternalProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionExternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTLSInspectionExternalProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTLSInspectionExternalProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "invalid_certificate_action", accTestPolicyTLSInspectionExternalProfileCreateAttributes["invalid_certificate_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTLSInspectionExternalProfileCreateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_enforcement", accTestPolicyTLSInspectionExternalProfileCreateAttributes["crypto_enforcement"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTLSInspectionExternalProfileCreateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_min_tls_version", accTestPolicyTLSInspectionExternalProfileCreateAttributes["server_min_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_max_tls_version", accTestPolicyTLSInspectionExternalProfileCreateAttributes["server_max_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "ocsp_must_staple", accTestPolicyTLSInspectionExternalProfileCreateAttributes["ocsp_must_staple"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "trusted_ca_certificate_path"),
+					resource.TestCheckResourceAttr(testResourceName, "trusted_ca_bundle_paths.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "crl_paths.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionExternalProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionExternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "invalid_certificate_action", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["invalid_certificate_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_enforcement", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["crypto_enforcement"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "server_min_tls_version", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["server_min_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_max_tls_version", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["server_max_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "ocsp_must_staple", accTestPolicyTLSInspectionExternalProfileUpdateAttributes["ocsp_must_staple"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionExternalProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionExternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionExternalProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_external_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyTLSInspectionExternalProfilePreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionExternalProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionExternalProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy TlsInspectionExternalProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy TlsInspectionExternalProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTLSInspectionExternalProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TlsInspectionExternalProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tls_inspection_external_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTLSInspectionExternalProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy TlsInspectionExternalProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfilePrerequisites() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_certificate" "ca" {
+  display_name = "%s"
+}
+
+data "nsxt_policy_certificate" "bundle" {
+  display_name = "%s"
+}`, getTestCACertificateName(), getTestCABundleName())
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyTLSInspectionExternalProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyTLSInspectionExternalProfileUpdateAttributes
+	}
+	return testAccNsxtPolicyTLSInspectionExternalProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_external_profile" "test" {
+  display_name                = "%s"
+  description                 = "%s"
+  invalid_certificate_action  = "%s"
+  decryption_fail_action      = "%s"
+  crypto_enforcement          = "%s"
+  tls_config_setting          = "%s"
+  client_cipher_suites        = ["%s"]
+  server_cipher_suites        = ["%s"]
+  server_min_tls_version      = "%s"
+  server_max_tls_version      = "%s"
+  ocsp_must_staple            = %s
+  trusted_ca_certificate_path = data.nsxt_policy_certificate.ca.path
+  trusted_ca_bundle_paths     = [data.nsxt_policy_certificate.bundle.path]
+  crl_paths                   = ["%s"]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["invalid_certificate_action"], attrMap["decryption_fail_action"], attrMap["crypto_enforcement"], attrMap["tls_config_setting"], attrMap["client_cipher_suites"], attrMap["server_cipher_suites"], attrMap["server_min_tls_version"], attrMap["server_max_tls_version"], attrMap["ocsp_must_staple"], getTestCrlPath())
+}
+
+func testAccNsxtPolicyTLSInspectionExternalProfileMinimalistic() string {
+	return testAccNsxtPolicyTLSInspectionExternalProfilePrerequisites() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_external_profile" "test" {
+  display_name                = "%s"
+  trusted_ca_certificate_path = data.nsxt_policy_certificate.ca.path
+  trusted_ca_bundle_paths     = [data.nsxt_policy_certificate.bundle.path]
+  crl_paths                   = ["%s"]
+}`, accTestPolicyTLSInspectionExternalProfileUpdateAttributes["display_name"], getTestCrlPath())
+}
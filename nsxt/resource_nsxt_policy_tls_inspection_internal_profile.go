@@ -0,0 +1,202 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTLSInspectionInternalProfile() *schema.Resource {
+	profileSchema := getTLSInspectionProfileCommonSchema()
+	profileSchema["certificate_validation"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Validate server certificate. If enabled, trusted CA bundle and CRL are required",
+		Optional:    true,
+		Default:     false,
+	}
+	profileSchema["default_certificate_path"] = getPolicyPathSchema(false, false, "Default server certificate and key to use for decryption")
+	profileSchema["server_certificate_paths"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "Server certificates and keys to use for decryption",
+		Required:    true,
+		Elem:        getElemPolicyPathSchema(),
+	}
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionInternalProfileCreate,
+		Read:   resourceNsxtPolicyTLSInspectionInternalProfileRead,
+		Update: resourceNsxtPolicyTLSInspectionInternalProfileUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionInternalProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: profileSchema,
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	return resourceNsxtPolicyTLSInspectionProfileExists(id, connector, isGlobalManager)
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	certificateValidation := d.Get("certificate_validation").(bool)
+	defaultCertKey := d.Get("default_certificate_path").(string)
+	cryptoEnforcement := d.Get("crypto_enforcement").(string)
+	decryptionFailAction := d.Get("decryption_fail_action").(string)
+	ocspMustStaple := d.Get("ocsp_must_staple").(bool)
+	tlsConfigSetting := d.Get("tls_config_setting").(string)
+	clientMaxTLSVersion := d.Get("client_max_tls_version").(string)
+	clientMinTLSVersion := d.Get("client_min_tls_version").(string)
+	serverMaxTLSVersion := d.Get("server_max_tls_version").(string)
+	serverMinTLSVersion := d.Get("server_min_tls_version").(string)
+	idleConnectionTimeout := int64(d.Get("idle_connection_timeout").(int))
+
+	obj := model.TlsInspectionInternalProfile{
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		CertificateValidation: &certificateValidation,
+		ServerCertsKey:        getStringListFromSchemaSet(d, "server_certificate_paths"),
+		ClientCipherSuite:     getStringListFromSchemaSet(d, "client_cipher_suites"),
+		ServerCipherSuite:     getStringListFromSchemaSet(d, "server_cipher_suites"),
+		CryptoEnforcement:     &cryptoEnforcement,
+		DecryptionFailAction:  &decryptionFailAction,
+		OcspMustStaple:        &ocspMustStaple,
+		TlsConfigSetting:      &tlsConfigSetting,
+		Crls:                  getStringListFromSchemaSet(d, "crl_paths"),
+		TrustedCaBundles:      getStringListFromSchemaSet(d, "trusted_ca_bundle_paths"),
+		ResourceType:          model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONINTERNALPROFILE,
+	}
+
+	if len(defaultCertKey) > 0 {
+		obj.DefaultCertKey = &defaultCertKey
+	}
+	if len(clientMaxTLSVersion) > 0 {
+		obj.ClientMaxTlsVersion = &clientMaxTLSVersion
+	}
+	if len(clientMinTLSVersion) > 0 {
+		obj.ClientMinTlsVersion = &clientMinTLSVersion
+	}
+	if len(serverMaxTLSVersion) > 0 {
+		obj.ServerMaxTlsVersion = &serverMaxTLSVersion
+	}
+	if len(serverMinTLSVersion) > 0 {
+		obj.ServerMinTlsVersion = &serverMinTLSVersion
+	}
+	if idleConnectionTimeout > 0 {
+		obj.IdleConnectionTimeout = &idleConnectionTimeout
+	}
+
+	log.Printf("[INFO] Patching TlsInspectionInternalProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.TlsInspectionInternalProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting TlsInspectionInternalProfile %s", errs[0])
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Patch(id, dataValue.(*data.StructValue))
+	return err
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionInternalProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyTLSInspectionInternalProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("TlsInspectionInternalProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionInternalProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TlsInspectionInternalProfile ID")
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TlsInspectionInternalProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.TlsInspectionInternalProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("TLS Inspection Profile with id %s is not of type TlsInspectionInternalProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.TlsInspectionInternalProfile)
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("certificate_validation", profile.CertificateValidation)
+	d.Set("default_certificate_path", profile.DefaultCertKey)
+	d.Set("server_certificate_paths", profile.ServerCertsKey)
+	d.Set("client_cipher_suites", profile.ClientCipherSuite)
+	d.Set("client_max_tls_version", profile.ClientMaxTlsVersion)
+	d.Set("client_min_tls_version", profile.ClientMinTlsVersion)
+	d.Set("server_cipher_suites", profile.ServerCipherSuite)
+	d.Set("server_max_tls_version", profile.ServerMaxTlsVersion)
+	d.Set("server_min_tls_version", profile.ServerMinTlsVersion)
+	d.Set("crypto_enforcement", profile.CryptoEnforcement)
+	d.Set("decryption_fail_action", profile.DecryptionFailAction)
+	d.Set("ocsp_must_staple", profile.OcspMustStaple)
+	d.Set("tls_config_setting", profile.TlsConfigSetting)
+	d.Set("crl_paths", profile.Crls)
+	d.Set("trusted_ca_bundle_paths", profile.TrustedCaBundles)
+	d.Set("idle_connection_timeout", profile.IdleConnectionTimeout)
+	d.Set("attention", profile.Attention)
+
+	return nil
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TlsInspectionInternalProfile ID")
+	}
+
+	err := resourceNsxtPolicyTLSInspectionInternalProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("TlsInspectionInternalProfile", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionInternalProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionInternalProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyTLSInspectionProfileDelete(d, m)
+}
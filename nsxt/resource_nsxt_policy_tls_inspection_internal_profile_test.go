@@ -0,0 +1,225 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyTLSInspectionInternalProfileCreateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform created",
+	"decryption_fail_action": "BLOCK",
+	"crypto_enforcement":     "ENFORCE",
+	"tls_config_setting":     "CUSTOM",
+	"client_cipher_suites":   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"client_min_tls_version": "TLS_V1_1",
+	"client_max_tls_version": "TLS_V1_2",
+	"ocsp_must_staple":       "true",
+}
+
+var accTestPolicyTLSInspectionInternalProfileUpdateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform updated",
+	"decryption_fail_action": "BYPASS",
+	"crypto_enforcement":     "TRANSPARENT",
+	"tls_config_setting":     "CUSTOM",
+	"client_cipher_suites":   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"client_min_tls_version": "TLS_V1_2",
+	"client_max_tls_version": "TLS_V1_2",
+	"ocsp_must_staple":       "false",
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionInternalProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_inspection_internal_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionInternalProfileCheckDestroy(state, accTestPolicyTLSInspectionInternalProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionInternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTLSInspectionInternalProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTLSInspectionInternalProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTLSInspectionInternalProfileCreateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_enforcement", accTestPolicyTLSInspectionInternalProfileCreateAttributes["crypto_enforcement"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTLSInspectionInternalProfileCreateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "client_min_tls_version", accTestPolicyTLSInspectionInternalProfileCreateAttributes["client_min_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_max_tls_version", accTestPolicyTLSInspectionInternalProfileCreateAttributes["client_max_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "ocsp_must_staple", accTestPolicyTLSInspectionInternalProfileCreateAttributes["ocsp_must_staple"]),
+					resource.TestCheckResourceAttr(testResourceName, "server_certificate_paths.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionInternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "crypto_enforcement", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["crypto_enforcement"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_cipher_suites.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "client_min_tls_version", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["client_min_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "client_max_tls_version", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["client_max_tls_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "ocsp_must_staple", accTestPolicyTLSInspectionInternalProfileUpdateAttributes["ocsp_must_staple"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionInternalProfileExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionInternalProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_internal_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionInternalProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionInternalProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionInternalProfileExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy TlsInspectionInternalProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy TlsInspectionInternalProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTLSInspectionInternalProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TlsInspectionInternalProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTLSInspectionInternalProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tls_inspection_internal_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTLSInspectionInternalProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy TlsInspectionInternalProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTLSInspectionCertificateTemplate() string {
+	return fmt.Sprintf(`
+data "nsxt_policy_certificate" "test" {
+  display_name = "%s"
+}`, getTestCertificateName(false))
+}
+
+func testAccNsxtPolicyTLSInspectionInternalProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyTLSInspectionInternalProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyTLSInspectionInternalProfileUpdateAttributes
+	}
+	return testAccNsxtPolicyTLSInspectionCertificateTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_internal_profile" "test" {
+  display_name             = "%s"
+  description              = "%s"
+  decryption_fail_action   = "%s"
+  crypto_enforcement       = "%s"
+  tls_config_setting       = "%s"
+  client_cipher_suites     = ["%s"]
+  client_min_tls_version   = "%s"
+  client_max_tls_version   = "%s"
+  ocsp_must_staple         = %s
+  server_certificate_paths = [data.nsxt_policy_certificate.test.path]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["decryption_fail_action"], attrMap["crypto_enforcement"], attrMap["tls_config_setting"], attrMap["client_cipher_suites"], attrMap["client_min_tls_version"], attrMap["client_max_tls_version"], attrMap["ocsp_must_staple"])
+}
+
+func testAccNsxtPolicyTLSInspectionInternalProfileMinimalistic() string {
+	return testAccNsxtPolicyTLSInspectionCertificateTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_internal_profile" "test" {
+  display_name             = "%s"
+  server_certificate_paths = [data.nsxt_policy_certificate.test.path]
+}`, accTestPolicyTLSInspectionInternalProfileUpdateAttributes["display_name"])
+}
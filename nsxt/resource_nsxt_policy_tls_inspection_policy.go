@@ -0,0 +1,384 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTLSInspectionPolicy() *schema.Resource {
+	policySchema := getPolicySecurityPolicySchema(false, false, false)
+	delete(policySchema, "domain")
+	delete(policySchema, "category")
+	delete(policySchema, "scope")
+	delete(policySchema, "tcp_strict")
+	policySchema["rule"] = getTLSInspectionRulesSchema()
+
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionPolicyCreate,
+		Read:   resourceNsxtPolicyTLSInspectionPolicyRead,
+		Update: resourceNsxtPolicyTLSInspectionPolicyUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: policySchema,
+	}
+}
+
+func getTLSInspectionRulesSchema() *schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRuleSchema(false, false, true, false)
+	// TLS rules do not carry firewall action, the TLS action profile defines what
+	// happens with matched traffic
+	delete(ruleSchema, "action")
+	ruleSchema["tls_profile_path"] = getPolicyPathSchema(true, false, "Path of TLS inspection action profile to apply")
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "List of rules in the section",
+		Optional:    true,
+		MaxItems:    1000,
+		Elem: &schema.Resource{
+			Schema: ruleSchema,
+		},
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving TLS Inspection Policy", err)
+}
+
+func setPolicyTLSRulesInSchema(d *schema.ResourceData, rules []model.TlsRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["path"] = rule.Path
+		elem["notes"] = rule.Notes
+		elem["logged"] = rule.Logged
+		elem["log_label"] = rule.Tag
+		elem["destinations_excluded"] = rule.DestinationsExcluded
+		elem["sources_excluded"] = rule.SourcesExcluded
+		if rule.IpProtocol == nil {
+			elem["ip_version"] = "NONE"
+		} else {
+			elem["ip_version"] = rule.IpProtocol
+		}
+		elem["direction"] = rule.Direction
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		setPathListInMap(elem, "source_groups", rule.SourceGroups)
+		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+		setPathListInMap(elem, "profiles", rule.Profiles)
+		setPathListInMap(elem, "services", rule.Services)
+		setPathListInMap(elem, "scope", rule.Scope)
+		elem["sequence_number"] = rule.SequenceNumber
+		elem["nsx_id"] = rule.Id
+		elem["rule_id"] = rule.RuleId
+		elem["tls_profile_path"] = rule.TlsProfile
+
+		var tagList []map[string]string
+		for _, tag := range rule.Tags {
+			tags := make(map[string]string)
+			tags["scope"] = *tag.Scope
+			tags["tag"] = *tag.Tag
+			tagList = append(tagList, tags)
+		}
+		elem["tag"] = tagList
+
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyTLSRulesFromSchema(d *schema.ResourceData) []model.TlsRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []model.TlsRule
+	lastSequence := int64(0)
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		logged := data["logged"].(bool)
+		tag := data["log_label"].(string)
+		disabled := data["disabled"].(bool)
+		sourcesExcluded := data["sources_excluded"].(bool)
+		destinationsExcluded := data["destinations_excluded"].(bool)
+		tlsProfile := data["tls_profile_path"].(string)
+
+		var ipProtocol *string
+		ipp := data["ip_version"].(string)
+		if ipp != "NONE" {
+			ipProtocol = &ipp
+		}
+		direction := data["direction"].(string)
+		notes := data["notes"].(string)
+		sequenceNumber := int64(data["sequence_number"].(int))
+		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+
+		id := newUUID()
+		nsxID := data["nsx_id"].(string)
+		if nsxID != "" {
+			id = nsxID
+		}
+
+		if sequenceNumber == 0 || sequenceNumber <= lastSequence {
+			sequenceNumber = lastSequence + 1
+		}
+		lastSequence = sequenceNumber
+
+		resourceType := "TlsRule"
+		elem := model.TlsRule{
+			ResourceType:         &resourceType,
+			Id:                   &id,
+			DisplayName:          &displayName,
+			Notes:                &notes,
+			Description:          &description,
+			Logged:               &logged,
+			Tag:                  &tag,
+			Tags:                 tagStructs,
+			Disabled:             &disabled,
+			SourcesExcluded:      &sourcesExcluded,
+			DestinationsExcluded: &destinationsExcluded,
+			IpProtocol:           ipProtocol,
+			Direction:            &direction,
+			SourceGroups:         getPathListFromMap(data, "source_groups"),
+			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+			Services:             getPathListFromMap(data, "services"),
+			Scope:                getPathListFromMap(data, "scope"),
+			Profiles:             getPathListFromMap(data, "profiles"),
+			SequenceNumber:       &sequenceNumber,
+			TlsProfile:           &tlsProfile,
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+
+	return ruleList
+}
+
+func createPolicyChildTLSRule(ruleID string, rule model.TlsRule, shouldDelete bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childRule := model.ChildTlsRule{
+		ResourceType:    "ChildTlsRule",
+		Id:              &ruleID,
+		TlsRule:         &rule,
+		MarkedForDelete: &shouldDelete,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childRule, model.ChildTlsRuleBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func createChildTLSPolicy(policyID string, policy model.TlsPolicy) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childPolicy := model.ChildTlsPolicy{
+		Id:           &policyID,
+		ResourceType: "ChildTlsPolicy",
+		TlsPolicy:    &policy,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildTlsPolicyBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func updateTLSInspectionPolicy(id string, d *schema.ResourceData, m interface{}) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	stateful := d.Get("stateful").(bool)
+	resourceType := "TlsPolicy"
+
+	obj := model.TlsPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		Stateful:       &stateful,
+		ResourceType:   &resourceType,
+	}
+
+	var childRules []*data.StructValue
+	if d.HasChange("rule") {
+		oldRules, _ := d.GetChange("rule")
+		rules := getPolicyTLSRulesFromSchema(d)
+
+		existingRules := make(map[string]bool)
+		for _, rule := range rules {
+			ruleID := *rule.Id
+			existingRules[ruleID] = true
+
+			childRule, err := createPolicyChildTLSRule(ruleID, rule, false)
+			if err != nil {
+				return err
+			}
+			log.Printf("[DEBUG]: Adding child rule with id %s", ruleID)
+			childRules = append(childRules, childRule)
+		}
+
+		// We need to delete old rules that are not present in config anymore
+		for _, oldRule := range oldRules.([]interface{}) {
+			oldRuleMap := oldRule.(map[string]interface{})
+			oldRuleID := oldRuleMap["nsx_id"].(string)
+			if _, exists := existingRules[oldRuleID]; !exists {
+				resourceType := "TlsRule"
+				rule := model.TlsRule{
+					Id:           &oldRuleID,
+					ResourceType: &resourceType,
+				}
+
+				childRule, err := createPolicyChildTLSRule(oldRuleID, rule, true)
+				if err != nil {
+					return err
+				}
+				log.Printf("[DEBUG]: Deleting child rule with id %s", oldRuleID)
+				childRules = append(childRules, childRule)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG]: Updating TLS Inspection policy %s with %d child rules", id, len(childRules))
+	if len(childRules) > 0 {
+		obj.Children = childRules
+	}
+
+	childPolicy, err := createChildTLSPolicy(id, obj)
+	if err != nil {
+		return fmt.Errorf("Failed to create H-API for TLS Inspection Policy: %s", err)
+	}
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     []*data.StructValue{childPolicy},
+		ResourceType: &infraType,
+	}
+
+	return policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionPolicyExists)
+	if err != nil {
+		return err
+	}
+
+	err = validatePolicyRuleSequence(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Policy with ID %s", id)
+	err = updateTLSInspectionPolicy(id, d, m)
+	if err != nil {
+		return handleCreateError("TLS Inspection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("stateful", obj.Stateful)
+
+	return setPolicyTLSRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	err := validatePolicyRuleSequence(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating TLS Inspection Policy with ID %s", id)
+	err = updateTLSInspectionPolicy(id, d, m)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyCreate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "locked", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "stateful", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source_groups.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.tls_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyUpdate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.disabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.destination_groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyMinimalistic(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyCreate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTLSInspectionPolicyExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TLS Inspection Policy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tls_inspection_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTLSInspectionPolicyExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy TLS Inspection Policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyDeps() string {
+	return testAccNsxtPolicyTLSInspectionCertificateTemplate() + `
+resource "nsxt_policy_tls_inspection_internal_profile" "test" {
+  display_name             = "tf-tls-profile"
+  server_certificate_paths = [data.nsxt_policy_certificate.test.path]
+}
+
+resource "nsxt_policy_group" "group1" {
+  display_name = "tf-tls-group1"
+}
+
+resource "nsxt_policy_group" "group2" {
+  display_name = "tf-tls-group2"
+}`
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyCreate(name string) string {
+	return testAccNsxtPolicyTLSInspectionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  locked       = false
+  stateful     = true
+
+  rule {
+    display_name     = "rule1"
+    source_groups    = [nsxt_policy_group.group1.path]
+    tls_profile_path = nsxt_policy_tls_inspection_internal_profile.test.path
+  }
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyUpdate(name string) string {
+	return testAccNsxtPolicyTLSInspectionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  locked       = false
+  stateful     = true
+
+  rule {
+    display_name     = "rule1"
+    source_groups    = [nsxt_policy_group.group1.path]
+    tls_profile_path = nsxt_policy_tls_inspection_internal_profile.test.path
+  }
+
+  rule {
+    display_name       = "rule2"
+    destination_groups = [nsxt_policy_group.group2.path]
+    disabled           = true
+    tls_profile_path   = nsxt_policy_tls_inspection_internal_profile.test.path
+  }
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyMinimalistic(name string) string {
+	return testAccNsxtPolicyTLSInspectionPolicyDeps() + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name = "%s"
+}`, name)
+}
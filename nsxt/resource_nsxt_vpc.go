@@ -0,0 +1,388 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var vpcIPAddressTypeValues = []string{
+	model.Vpc_IP_ADDRESS_TYPE_IPV4,
+}
+
+func resourceNsxtVpc() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVpcCreate,
+		Read:   resourceNsxtVpcRead,
+		Update: resourceNsxtVpcUpdate,
+		Delete: resourceNsxtVpcDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchemaExtended(true, false),
+			"short_id": {
+				Type:        schema.TypeString,
+				Description: "Short identifier of the VPC",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"default_gateway_path": getPolicyPathSchema(false, false, "Path of Tier0 or Tier0 VRF that serves as default gateway for the VPC"),
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Description:  "IP address type for subnets of the VPC",
+				Optional:     true,
+				Default:      model.Vpc_IP_ADDRESS_TYPE_IPV4,
+				ValidateFunc: validation.StringInSlice(vpcIPAddressTypeValues, false),
+			},
+			"private_ipv4_blocks": {
+				Type:        schema.TypeList,
+				Description: "IP blocks used for allocating CIDR blocks for private subnets",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidr(),
+				},
+			},
+			"external_ipv4_blocks": {
+				Type:        schema.TypeList,
+				Description: "Paths of IP blocks used for allocating CIDR blocks for public subnets",
+				Optional:    true,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"ipv6_profile_paths": {
+				Type:        schema.TypeList,
+				Description: "Paths of IPv6 NDRA and DAD profiles",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    2,
+				Elem:        getElemPolicyPathSchema(),
+			},
+			"service_gateway": {
+				Type:        schema.TypeList,
+				Description: "Service gateway configuration for the VPC",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disable": {
+							Type:        schema.TypeBool,
+							Description: "Disable service gateway for the VPC",
+							Optional:    true,
+							Default:     false,
+						},
+						"auto_snat": {
+							Type:        schema.TypeBool,
+							Description: "Automatically create SNAT rule for private subnets",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+			"dhcp_config": {
+				Type:        schema.TypeList,
+				Description: "DHCP configuration for the VPC",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_dhcp": {
+							Type:        schema.TypeBool,
+							Description: "Enable DHCP for subnets of the VPC",
+							Optional:    true,
+							Default:     true,
+						},
+						"dns_server_ips": {
+							Type:        schema.TypeList,
+							Description: "IPs of DNS servers to be configured on workloads",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSingleIP(),
+							},
+						},
+					},
+				},
+			},
+			"load_balancer_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable load balancer endpoint for the VPC",
+				Optional:    true,
+				Default:     false,
+			},
+			"site_info": {
+				Type:        schema.TypeList,
+				Description: "Information related to sites applicable for the VPC",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_cluster_paths": {
+							Type:     schema.TypeList,
+							Elem:     getElemPolicyPathSchemaWithFlags(false, false, false),
+							Optional: true,
+						},
+						"site_path": getElemPolicyPathSchemaWithFlags(true, true, false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtVpcExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := projects.NewVpcsClient(connector)
+	_, err := client.Get(defaultOrgID, sessionContext.ProjectID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving VPC", err)
+}
+
+func getVpcServiceGatewayFromSchema(d *schema.ResourceData) *model.ServiceGateway {
+	for _, item := range d.Get("service_gateway").([]interface{}) {
+		data := item.(map[string]interface{})
+		disable := data["disable"].(bool)
+		autoSnat := data["auto_snat"].(bool)
+		return &model.ServiceGateway{
+			Disable:  &disable,
+			AutoSnat: &autoSnat,
+		}
+	}
+
+	return nil
+}
+
+func setVpcServiceGatewayInSchema(d *schema.ResourceData, gateway *model.ServiceGateway) error {
+	var result []map[string]interface{}
+	if gateway != nil {
+		elem := make(map[string]interface{})
+		elem["disable"] = gateway.Disable
+		elem["auto_snat"] = gateway.AutoSnat
+		result = append(result, elem)
+	}
+
+	return d.Set("service_gateway", result)
+}
+
+func getVpcDhcpConfigFromSchema(d *schema.ResourceData) *model.DhcpConfig {
+	for _, item := range d.Get("dhcp_config").([]interface{}) {
+		data := item.(map[string]interface{})
+		enableDhcp := data["enable_dhcp"].(bool)
+		config := model.DhcpConfig{
+			EnableDhcp: &enableDhcp,
+		}
+		dnsServers := interfaceListToStringList(data["dns_server_ips"].([]interface{}))
+		if len(dnsServers) > 0 {
+			config.DnsClientConfig = &model.DnsClientConfig{
+				DnsServerIps: dnsServers,
+			}
+		}
+		return &config
+	}
+
+	return nil
+}
+
+func setVpcDhcpConfigInSchema(d *schema.ResourceData, config *model.DhcpConfig) error {
+	var result []map[string]interface{}
+	if config != nil {
+		elem := make(map[string]interface{})
+		elem["enable_dhcp"] = config.EnableDhcp
+		if config.DnsClientConfig != nil {
+			elem["dns_server_ips"] = config.DnsClientConfig.DnsServerIps
+		}
+		result = append(result, elem)
+	}
+
+	return d.Set("dhcp_config", result)
+}
+
+func getVpcSiteInfosFromSchema(d *schema.ResourceData) []model.SiteInfo {
+	var siteInfos []model.SiteInfo
+	for _, item := range d.Get("site_info").([]interface{}) {
+		data := item.(map[string]interface{})
+		sitePath := data["site_path"].(string)
+		siteInfos = append(siteInfos, model.SiteInfo{
+			EdgeClusterPaths: interfaceListToStringList(data["edge_cluster_paths"].([]interface{})),
+			SitePath:         &sitePath,
+		})
+	}
+
+	return siteInfos
+}
+
+func setVpcSiteInfosInSchema(d *schema.ResourceData, siteInfos []model.SiteInfo) error {
+	var result []map[string]interface{}
+	for _, item := range siteInfos {
+		elem := make(map[string]interface{})
+		elem["edge_cluster_paths"] = item.EdgeClusterPaths
+		elem["site_path"] = item.SitePath
+		result = append(result, elem)
+	}
+
+	return d.Set("site_info", result)
+}
+
+func resourceNsxtVpcPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	shortID := d.Get("short_id").(string)
+	defaultGatewayPath := d.Get("default_gateway_path").(string)
+	ipAddressType := d.Get("ip_address_type").(string)
+	loadBalancerEnabled := d.Get("load_balancer_enabled").(bool)
+
+	obj := model.Vpc{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		IpAddressType:      &ipAddressType,
+		PrivateIpv4Blocks:  getStringListFromSchemaList(d, "private_ipv4_blocks"),
+		ExternalIpv4Blocks: getStringListFromSchemaList(d, "external_ipv4_blocks"),
+		Ipv6ProfilePaths:   getStringListFromSchemaList(d, "ipv6_profile_paths"),
+		ServiceGateway:     getVpcServiceGatewayFromSchema(d),
+		DhcpConfig:         getVpcDhcpConfigFromSchema(d),
+		SiteInfos:          getVpcSiteInfosFromSchema(d),
+		LoadBalancerVpcEndpoint: &model.LoadBalancerVPCEndpoint{
+			Enabled: &loadBalancerEnabled,
+		},
+	}
+
+	if len(shortID) > 0 {
+		obj.ShortId = &shortID
+	}
+
+	if len(defaultGatewayPath) > 0 {
+		obj.DefaultGatewayPath = &defaultGatewayPath
+	}
+
+	log.Printf("[INFO] Patching VPC with ID %s", id)
+	client := projects.NewVpcsClient(connector)
+	return client.Patch(defaultOrgID, getProjectIDFromSchema(d), id, obj)
+}
+
+func resourceNsxtVpcCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtVpcExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtVpcPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("VPC", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtVpcRead(d, m)
+}
+
+func resourceNsxtVpcRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC ID")
+	}
+
+	client := projects.NewVpcsClient(connector)
+	obj, err := client.Get(defaultOrgID, getProjectIDFromSchema(d), id)
+	if err != nil {
+		return handleReadError(d, "VPC", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("short_id", obj.ShortId)
+	d.Set("default_gateway_path", obj.DefaultGatewayPath)
+	d.Set("ip_address_type", obj.IpAddressType)
+	d.Set("private_ipv4_blocks", obj.PrivateIpv4Blocks)
+	d.Set("external_ipv4_blocks", obj.ExternalIpv4Blocks)
+	d.Set("ipv6_profile_paths", obj.Ipv6ProfilePaths)
+	if obj.LoadBalancerVpcEndpoint != nil {
+		d.Set("load_balancer_enabled", obj.LoadBalancerVpcEndpoint.Enabled)
+	}
+
+	err = setVpcServiceGatewayInSchema(d, obj.ServiceGateway)
+	if err != nil {
+		return err
+	}
+
+	err = setVpcDhcpConfigInSchema(d, obj.DhcpConfig)
+	if err != nil {
+		return err
+	}
+
+	return setVpcSiteInfosInSchema(d, obj.SiteInfos)
+}
+
+func resourceNsxtVpcUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC ID")
+	}
+
+	err := resourceNsxtVpcPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("VPC", id, err)
+	}
+
+	return resourceNsxtVpcRead(d, m)
+}
+
+func resourceNsxtVpcDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := projects.NewVpcsClient(connector)
+	err := client.Delete(defaultOrgID, getProjectIDFromSchema(d), id)
+	if err != nil {
+		return handleDeleteError("VPC", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtVpcGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVpcGroupCreate,
+		Read:   resourceNsxtVpcGroupRead,
+		Update: resourceNsxtVpcGroupUpdate,
+		Delete: resourceNsxtVpcGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: getVpcGroupSchema(),
+	}
+}
+
+func getVpcGroupSchema() map[string]*schema.Schema {
+	s := getPolicyGroupSchema(false)
+	s["context"] = getContextSchemaExtended(true, true)
+	return s
+}
+
+func resourceNsxtVpcGroupCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	return resourceNsxtPolicyGroupGeneralCreate(d, m, false)
+}
+
+func resourceNsxtVpcGroupRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralRead(d, m, false)
+}
+
+func resourceNsxtVpcGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralUpdate(d, m, false)
+}
+
+func resourceNsxtVpcGroupDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyGroupGeneralDelete(d, m, false)
+}
@@ -0,0 +1,143 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtVpcGroup_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcGroupCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcGroupTemplate(name, "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcGroupExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "criteria.0.ipaddress_expression.0.ip_addresses.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtVpcGroupTemplate(updatedName, "2.2.2.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcGroupExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "criteria.0.ipaddress_expression.0.ip_addresses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtVpcGroup_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcGroupCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcGroupTemplate(name, "1.1.1.1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVpcGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPC Group resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("VPC Group resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyGroupExistsInDomain(testAccGetVpcSessionContext(rs), resourceID, "", connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("VPC Group %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtVpcGroupCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_vpc_group" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyGroupExistsInDomain(testAccGetVpcSessionContext(rs), resourceID, "", connector)
+		if err == nil && exists {
+			return fmt.Errorf("VPC Group %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtVpcGroupTemplate(name string, ipAddress string) string {
+	return testAccNsxtVpcParentTemplate() + fmt.Sprintf(`
+resource "nsxt_vpc_group" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["%s"]
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, testAccNsxtVpcContext(), name, ipAddress)
+}
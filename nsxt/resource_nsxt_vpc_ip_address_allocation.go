@@ -0,0 +1,197 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var vpcIPAddressAllocationVisibilityValues = []string{
+	model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+	model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_PRIVATE,
+}
+
+var vpcIPAddressAllocationTypeValues = []string{
+	model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+	model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6,
+}
+
+func resourceNsxtVpcIPAddressAllocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVpcIPAddressAllocationCreate,
+		Read:   resourceNsxtVpcIPAddressAllocationRead,
+		Update: resourceNsxtVpcIPAddressAllocationUpdate,
+		Delete: resourceNsxtVpcIPAddressAllocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchemaExtended(true, true),
+			"allocation_ip": {
+				Type:         schema.TypeString,
+				Description:  "IP address to allocate. If not specified, any available IP is allocated",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSingleIP(),
+			},
+			"ip_address_block_visibility": {
+				Type:         schema.TypeString,
+				Description:  "Visibility of the IP block to allocate from",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+				ValidateFunc: validation.StringInSlice(vpcIPAddressAllocationVisibilityValues, false),
+			},
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of IP address to allocate",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+				ValidateFunc: validation.StringInSlice(vpcIPAddressAllocationTypeValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtVpcIPAddressAllocationExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := vpcs.NewIpAddressAllocationsClient(connector)
+	_, err := client.Get(defaultOrgID, sessionContext.ProjectID, sessionContext.VPCID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving VPC IP Address Allocation", err)
+}
+
+func resourceNsxtVpcIPAddressAllocationPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	allocationIP := d.Get("allocation_ip").(string)
+	visibility := d.Get("ip_address_block_visibility").(string)
+	ipAddressType := d.Get("ip_address_type").(string)
+
+	obj := model.VpcIpAddressAllocation{
+		DisplayName:              &displayName,
+		Description:              &description,
+		Tags:                     tags,
+		IpAddressBlockVisibility: &visibility,
+		IpAddressType:            &ipAddressType,
+	}
+
+	if len(allocationIP) > 0 {
+		obj.AllocationIp = &allocationIP
+	}
+
+	log.Printf("[INFO] Patching VPC IP Address Allocation with ID %s", id)
+	client := vpcs.NewIpAddressAllocationsClient(connector)
+	return client.Patch(defaultOrgID, context.ProjectID, context.VPCID, id, obj)
+}
+
+func resourceNsxtVpcIPAddressAllocationCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtVpcIPAddressAllocationExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtVpcIPAddressAllocationPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("VPC IP Address Allocation", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtVpcIPAddressAllocationRead(d, m)
+}
+
+func resourceNsxtVpcIPAddressAllocationRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC IP Address Allocation ID")
+	}
+
+	client := vpcs.NewIpAddressAllocationsClient(connector)
+	obj, err := client.Get(defaultOrgID, context.ProjectID, context.VPCID, id)
+	if err != nil {
+		return handleReadError(d, "VPC IP Address Allocation", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("allocation_ip", obj.AllocationIp)
+	d.Set("ip_address_block_visibility", obj.IpAddressBlockVisibility)
+	d.Set("ip_address_type", obj.IpAddressType)
+
+	return nil
+}
+
+func resourceNsxtVpcIPAddressAllocationUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC IP Address Allocation ID")
+	}
+
+	err := resourceNsxtVpcIPAddressAllocationPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("VPC IP Address Allocation", id, err)
+	}
+
+	return resourceNsxtVpcIPAddressAllocationRead(d, m)
+}
+
+func resourceNsxtVpcIPAddressAllocationDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC IP Address Allocation ID")
+	}
+
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	client := vpcs.NewIpAddressAllocationsClient(connector)
+	err := client.Delete(defaultOrgID, context.ProjectID, context.VPCID, id)
+	if err != nil {
+		return handleDeleteError("VPC IP Address Allocation", id, err)
+	}
+
+	return nil
+}
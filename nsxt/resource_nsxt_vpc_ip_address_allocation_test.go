@@ -0,0 +1,117 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtVpcIPAddressAllocation_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_ip_address_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcIPAddressAllocationCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcIPAddressAllocationTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcIPAddressAllocationExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_block_visibility", "EXTERNAL"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttrSet(testResourceName, "allocation_ip"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtVpcIPAddressAllocationTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcIPAddressAllocationExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "allocation_ip"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVpcIPAddressAllocationExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPC IP Address Allocation resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("VPC IP Address Allocation resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtVpcIPAddressAllocationExists(testAccGetVpcSessionContext(rs), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("VPC IP Address Allocation %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtVpcIPAddressAllocationCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_vpc_ip_address_allocation" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtVpcIPAddressAllocationExists(testAccGetVpcSessionContext(rs), resourceID, connector)
+		if err == nil && exists {
+			return fmt.Errorf("VPC IP Address Allocation %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtVpcIPAddressAllocationTemplate(name string) string {
+	return testAccNsxtVpcParentTemplate() + fmt.Sprintf(`
+resource "nsxt_vpc_ip_address_allocation" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, testAccNsxtVpcContext(), name)
+}
@@ -0,0 +1,47 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtVpcSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVpcSecurityPolicyCreate,
+		Read:   resourceNsxtVpcSecurityPolicyRead,
+		Update: resourceNsxtVpcSecurityPolicyUpdate,
+		Delete: resourceNsxtVpcSecurityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: getVpcSecurityPolicySchema(),
+	}
+}
+
+func getVpcSecurityPolicySchema() map[string]*schema.Schema {
+	s := getPolicySecurityPolicySchema(false, true, true)
+	delete(s, "domain")
+	s["context"] = getContextSchemaExtended(true, true)
+	return s
+}
+
+func resourceNsxtVpcSecurityPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+	return resourceNsxtPolicySecurityPolicyGeneralCreate(d, m, true, true)
+}
+
+func resourceNsxtVpcSecurityPolicyRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicySecurityPolicyGeneralRead(d, m, true, true)
+}
+
+func resourceNsxtVpcSecurityPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicySecurityPolicyGeneralUpdate(d, m, true, true)
+}
+
+func resourceNsxtVpcSecurityPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicySecurityPolicyGeneralDelete(d, m, true)
+}
@@ -0,0 +1,159 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/domains"
+)
+
+func TestAccResourceNsxtVpcSecurityPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcSecurityPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcSecurityPolicyTemplate(name, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcSecurityPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "category", "Application"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.source_groups.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtVpcSecurityPolicyTemplate(updatedName, "DROP"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcSecurityPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DROP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtVpcSecurityPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcSecurityPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcSecurityPolicyTemplate(name, "ALLOW"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVpcSecurityPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPC Security Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("VPC Security Policy resource ID not set in resources")
+		}
+
+		client := domains.NewSecurityPoliciesClient(testAccGetVpcSessionContext(rs), connector)
+		_, err := client.Get("", resourceID)
+		if err != nil {
+			return fmt.Errorf("Error while retrieving VPC Security Policy %s: %v", resourceID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtVpcSecurityPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_vpc_security_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySecurityPolicyExistsInDomain(testAccGetVpcSessionContext(rs), resourceID, "", connector)
+		if err == nil && exists {
+			return fmt.Errorf("VPC Security Policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtVpcSecurityPolicyTemplate(name string, action string) string {
+	context := testAccNsxtVpcContext()
+	return testAccNsxtVpcParentTemplate() + fmt.Sprintf(`
+resource "nsxt_vpc_group" "test" {
+%s
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.1.1.0/24"]
+    }
+  }
+}
+
+resource "nsxt_vpc_security_policy" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+  category     = "Application"
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_vpc_group.test.path]
+    action        = "%s"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, name, context, name, action)
+}
@@ -0,0 +1,288 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var vpcSubnetAccessModeValues = []string{
+	model.VpcSubnet_ACCESS_MODE_PRIVATE,
+	model.VpcSubnet_ACCESS_MODE_PUBLIC,
+	model.VpcSubnet_ACCESS_MODE_ISOLATED,
+}
+
+func resourceNsxtVpcSubnet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtVpcSubnetCreate,
+		Read:   resourceNsxtVpcSubnetRead,
+		Update: resourceNsxtVpcSubnetUpdate,
+		Delete: resourceNsxtVpcSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchemaExtended(true, true),
+			"access_mode": {
+				Type:         schema.TypeString,
+				Description:  "Subnet access mode",
+				Optional:     true,
+				Default:      model.VpcSubnet_ACCESS_MODE_PRIVATE,
+				ValidateFunc: validation.StringInSlice(vpcSubnetAccessModeValues, false),
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Subnet CIDRs. If not specified, CIDR is allocated from VPC IP blocks",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidr(),
+				},
+			},
+			"ipv4_subnet_size": {
+				Type:         schema.TypeInt,
+				Description:  "Size of subnet to be allocated from VPC IP blocks",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePowerOf2(false, 0),
+			},
+			"static_ip_allocation_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Enable IP and MAC address allocation for subnet ports from static IP pool",
+				Optional:    true,
+				Computed:    true,
+			},
+			"dhcp_config": {
+				Type:        schema.TypeList,
+				Description: "DHCP configuration for the subnet",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_dhcp": {
+							Type:        schema.TypeBool,
+							Description: "Enable DHCP for the subnet",
+							Optional:    true,
+							Default:     true,
+						},
+						"dns_server_ips": {
+							Type:        schema.TypeList,
+							Description: "IPs of DNS servers to be configured on workloads",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateSingleIP(),
+							},
+						},
+						"static_pool_size": {
+							Type:         schema.TypeInt,
+							Description:  "Number of IPs to be reserved in static IP pool",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtVpcSubnetExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := vpcs.NewSubnetsClient(connector)
+	_, err := client.Get(defaultOrgID, sessionContext.ProjectID, sessionContext.VPCID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving VPC Subnet", err)
+}
+
+func getVpcSubnetDhcpConfigFromSchema(d *schema.ResourceData) *model.VpcSubnetDhcpConfig {
+	for _, item := range d.Get("dhcp_config").([]interface{}) {
+		data := item.(map[string]interface{})
+		enableDhcp := data["enable_dhcp"].(bool)
+		config := model.VpcSubnetDhcpConfig{
+			EnableDhcp: &enableDhcp,
+		}
+		dnsServers := interfaceListToStringList(data["dns_server_ips"].([]interface{}))
+		if len(dnsServers) > 0 {
+			config.DnsClientConfig = &model.DnsClientConfig{
+				DnsServerIps: dnsServers,
+			}
+		}
+		poolSize := int64(data["static_pool_size"].(int))
+		if poolSize > 0 {
+			config.StaticPoolConfig = &model.StaticPoolConfig{
+				Ipv4PoolSize: &poolSize,
+			}
+		}
+		return &config
+	}
+
+	return nil
+}
+
+func setVpcSubnetDhcpConfigInSchema(d *schema.ResourceData, config *model.VpcSubnetDhcpConfig) error {
+	var result []map[string]interface{}
+	if config != nil {
+		elem := make(map[string]interface{})
+		elem["enable_dhcp"] = config.EnableDhcp
+		if config.DnsClientConfig != nil {
+			elem["dns_server_ips"] = config.DnsClientConfig.DnsServerIps
+		}
+		if config.StaticPoolConfig != nil {
+			elem["static_pool_size"] = config.StaticPoolConfig.Ipv4PoolSize
+		}
+		result = append(result, elem)
+	}
+
+	return d.Set("dhcp_config", result)
+}
+
+func resourceNsxtVpcSubnetPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	accessMode := d.Get("access_mode").(string)
+	ipv4SubnetSize := int64(d.Get("ipv4_subnet_size").(int))
+
+	obj := model.VpcSubnet{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		AccessMode:  &accessMode,
+		IpAddresses: getStringListFromSchemaList(d, "ip_addresses"),
+		DhcpConfig:  getVpcSubnetDhcpConfigFromSchema(d),
+	}
+
+	if ipv4SubnetSize > 0 {
+		obj.Ipv4SubnetSize = &ipv4SubnetSize
+	}
+
+	staticIPAllocation, isSet := d.GetOkExists("static_ip_allocation_enabled")
+	if isSet {
+		enabled := staticIPAllocation.(bool)
+		obj.AdvancedConfig = &model.SubnetAdvancedConfig{
+			StaticIpAllocation: &model.StaticIpAllocation{
+				Enabled: &enabled,
+			},
+		}
+	}
+
+	log.Printf("[INFO] Patching VPC Subnet with ID %s", id)
+	client := vpcs.NewSubnetsClient(connector)
+	return client.Patch(defaultOrgID, context.ProjectID, context.VPCID, id, obj)
+}
+
+func resourceNsxtVpcSubnetCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtVpcSubnetExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtVpcSubnetPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("VPC Subnet", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtVpcSubnetRead(d, m)
+}
+
+func resourceNsxtVpcSubnetRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC Subnet ID")
+	}
+
+	client := vpcs.NewSubnetsClient(connector)
+	obj, err := client.Get(defaultOrgID, context.ProjectID, context.VPCID, id)
+	if err != nil {
+		return handleReadError(d, "VPC Subnet", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("access_mode", obj.AccessMode)
+	d.Set("ip_addresses", obj.IpAddresses)
+	d.Set("ipv4_subnet_size", obj.Ipv4SubnetSize)
+	if obj.AdvancedConfig != nil && obj.AdvancedConfig.StaticIpAllocation != nil {
+		d.Set("static_ip_allocation_enabled", obj.AdvancedConfig.StaticIpAllocation.Enabled)
+	}
+
+	return setVpcSubnetDhcpConfigInSchema(d, obj.DhcpConfig)
+}
+
+func resourceNsxtVpcSubnetUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC Subnet ID")
+	}
+
+	err := resourceNsxtVpcSubnetPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("VPC Subnet", id, err)
+	}
+
+	return resourceNsxtVpcSubnetRead(d, m)
+}
+
+func resourceNsxtVpcSubnetDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining VPC Subnet ID")
+	}
+
+	connector := getPolicyConnector(m)
+	context := getSessionContext(d, m)
+	client := vpcs.NewSubnetsClient(connector)
+	err := client.Delete(defaultOrgID, context.ProjectID, context.VPCID, id)
+	if err != nil {
+		return handleDeleteError("VPC Subnet", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtVpc_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcTemplate(name, "192.168.240.0/24", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttr(testResourceName, "private_ipv4_blocks.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "private_ipv4_blocks.0", "192.168.240.0/24"),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.0.auto_snat", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.0.dns_server_ips.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "short_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtVpcTemplate(updatedName, "192.168.241.0/24", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVpcExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "private_ipv4_blocks.0", "192.168.241.0/24"),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.0.auto_snat", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtVpc_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVpcCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVpcTemplate(name, "192.168.242.0/24", true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVpcExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPC resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("VPC resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtVpcExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("VPC %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtVpcCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_vpc" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtVpcExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("VPC %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtVpcTemplate(name string, privateBlock string, autoSnat bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_vpc" "test" {
+  context {
+    project_id = "%s"
+  }
+
+  display_name        = "%s"
+  description         = "Acceptance Test"
+  private_ipv4_blocks = ["%s"]
+
+  service_gateway {
+    auto_snat = %t
+  }
+
+  dhcp_config {
+    enable_dhcp    = true
+    dns_server_ips = ["10.10.10.10"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, os.Getenv("NSXT_PROJECT_ID"), name, privateBlock, autoSnat)
+}
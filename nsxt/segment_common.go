@@ -292,6 +292,7 @@ func getPolicyCommonSegmentSchema(vlanRequired bool, isFixed bool) map[string]*s
 			Description: "Configuration for extending Segment through L2 VPN",
 			Elem:        getPolicySegmentL2ExtensionConfigurationSchema(),
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 		},
 		"overlay_id": {
@@ -493,6 +494,15 @@ func getSegmentSubnetDhcpConfigFromSchema(schemaConfig map[string]interface{}) (
 		return nil, fmt.Errorf("Only one of ['dhcp_v4_config','dhcp_v6_config'] should be specified in single subnet")
 	}
 
+	cidr := schemaConfig["cidr"].(string)
+	if len(dhcpV4Config) > 0 && isIPv6Address(cidr) {
+		return nil, fmt.Errorf("dhcp_v4_config is not applicable for IPv6 subnet %s", cidr)
+	}
+
+	if len(dhcpV6Config) > 0 && !isIPv6Address(cidr) {
+		return nil, fmt.Errorf("dhcp_v6_config is not applicable for IPv4 subnet %s", cidr)
+	}
+
 	converter := bindings.NewTypeConverter()
 
 	if len(dhcpV4Config) > 0 {
@@ -965,56 +975,78 @@ func getOldProfileDataForRemoval(oldProfiles interface{}) (string, int64) {
 	return segmentProfileMapID, revision
 }
 
-func nsxtPolicySegmentDiscoveryProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
-	segmentProfileMapID := "default"
+// policySegmentProfileMapConfig holds profile binding map configuration that is
+// common for segments and segment ports
+type policySegmentProfileMapConfig struct {
+	id           string
+	revision     *int64
+	shouldDelete bool
+	profiles     map[string]interface{}
+}
+
+func (c *policySegmentProfileMapConfig) getProfilePath(attrName string) *string {
+	path, ok := c.profiles[attrName].(string)
+	if !ok || len(path) == 0 {
+		return nil
+	}
+
+	return &path
+}
+
+// getPolicySegmentProfileMapConfig returns nil if profile binding map is not
+// configured and there is nothing to remove
+func getPolicySegmentProfileMapConfig(d *schema.ResourceData, attrName string) *policySegmentProfileMapConfig {
+	config := policySegmentProfileMapConfig{
+		id:       "default",
+		profiles: make(map[string]interface{}),
+	}
 
-	ipDiscoveryProfilePath := ""
-	macDiscoveryProfilePath := ""
 	revision := int64(0)
-	shouldDelete := false
-	oldProfiles, newProfiles := d.GetChange("discovery_profile")
+	oldProfiles, newProfiles := d.GetChange(attrName)
 	if len(newProfiles.([]interface{})) > 0 {
-		profileMap := newProfiles.([]interface{})[0].(map[string]interface{})
-
-		ipDiscoveryProfilePath = profileMap["ip_discovery_profile_path"].(string)
-		macDiscoveryProfilePath = profileMap["mac_discovery_profile_path"].(string)
-		if len(profileMap["binding_map_path"].(string)) > 0 {
-			segmentProfileMapID = getPolicyIDFromPath(profileMap["binding_map_path"].(string))
+		config.profiles = newProfiles.([]interface{})[0].(map[string]interface{})
+		if len(config.profiles["binding_map_path"].(string)) > 0 {
+			config.id = getPolicyIDFromPath(config.profiles["binding_map_path"].(string))
 		}
 
-		revision = int64(profileMap["revision"].(int))
+		revision = int64(config.profiles["revision"].(int))
 	} else {
 		if len(oldProfiles.([]interface{})) == 0 {
-			return nil, nil
+			return nil
 		}
-		segmentProfileMapID, revision = getOldProfileDataForRemoval(oldProfiles)
-		shouldDelete = true
-	}
-
-	resourceType := "SegmentDiscoveryProfileBindingMap"
-	discoveryMap := model.SegmentDiscoveryProfileBindingMap{
-		ResourceType: &resourceType,
-		Id:           &segmentProfileMapID,
+		// Profile should be deleted
+		config.id, revision = getOldProfileDataForRemoval(oldProfiles)
+		config.shouldDelete = true
 	}
 
 	if len(oldProfiles.([]interface{})) > 0 {
 		// This is an update
-		discoveryMap.Revision = &revision
+		config.revision = &revision
 	}
 
-	if len(ipDiscoveryProfilePath) > 0 {
-		discoveryMap.IpDiscoveryProfilePath = &ipDiscoveryProfilePath
+	return &config
+}
+
+func nsxtPolicySegmentDiscoveryProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
+	config := getPolicySegmentProfileMapConfig(d, "discovery_profile")
+	if config == nil {
+		return nil, nil
 	}
 
-	if len(macDiscoveryProfilePath) > 0 {
-		discoveryMap.MacDiscoveryProfilePath = &macDiscoveryProfilePath
+	resourceType := "SegmentDiscoveryProfileBindingMap"
+	discoveryMap := model.SegmentDiscoveryProfileBindingMap{
+		ResourceType:            &resourceType,
+		Id:                      &config.id,
+		Revision:                config.revision,
+		IpDiscoveryProfilePath:  config.getProfilePath("ip_discovery_profile_path"),
+		MacDiscoveryProfilePath: config.getProfilePath("mac_discovery_profile_path"),
 	}
 
 	childConfig := model.ChildSegmentDiscoveryProfileBindingMap{
 		ResourceType:                      "ChildSegmentDiscoveryProfileBindingMap",
 		SegmentDiscoveryProfileBindingMap: &discoveryMap,
-		Id:                                &segmentProfileMapID,
-		MarkedForDelete:                   &shouldDelete,
+		Id:                                &config.id,
+		MarkedForDelete:                   &config.shouldDelete,
 	}
 
 	converter := bindings.NewTypeConverter()
@@ -1027,50 +1059,24 @@ func nsxtPolicySegmentDiscoveryProfileSetInStruct(d *schema.ResourceData) (*data
 }
 
 func nsxtPolicySegmentQosProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
-	segmentProfileMapID := "default"
-
-	qosProfilePath := ""
-	revision := int64(0)
-	oldProfiles, newProfiles := d.GetChange("qos_profile")
-	shouldDelete := false
-	if len(newProfiles.([]interface{})) > 0 {
-		profileMap := newProfiles.([]interface{})[0].(map[string]interface{})
-
-		qosProfilePath = profileMap["qos_profile_path"].(string)
-		if len(profileMap["binding_map_path"].(string)) > 0 {
-			segmentProfileMapID = getPolicyIDFromPath(profileMap["binding_map_path"].(string))
-		}
-
-		revision = int64(profileMap["revision"].(int))
-	} else {
-		if len(oldProfiles.([]interface{})) == 0 {
-			return nil, nil
-		}
-		// Profile should be deleted
-		segmentProfileMapID, revision = getOldProfileDataForRemoval(oldProfiles)
-		shouldDelete = true
+	config := getPolicySegmentProfileMapConfig(d, "qos_profile")
+	if config == nil {
+		return nil, nil
 	}
 
 	resourceType := "SegmentQoSProfileBindingMap"
 	qosMap := model.SegmentQosProfileBindingMap{
-		ResourceType: &resourceType,
-		Id:           &segmentProfileMapID,
-	}
-
-	if len(oldProfiles.([]interface{})) > 0 {
-		// This is an update
-		qosMap.Revision = &revision
-	}
-
-	if len(qosProfilePath) > 0 {
-		qosMap.QosProfilePath = &qosProfilePath
+		ResourceType:   &resourceType,
+		Id:             &config.id,
+		Revision:       config.revision,
+		QosProfilePath: config.getProfilePath("qos_profile_path"),
 	}
 
 	childConfig := model.ChildSegmentQosProfileBindingMap{
 		ResourceType:                "ChildSegmentQoSProfileBindingMap",
 		SegmentQosProfileBindingMap: &qosMap,
-		Id:                          &segmentProfileMapID,
-		MarkedForDelete:             &shouldDelete,
+		Id:                          &config.id,
+		MarkedForDelete:             &config.shouldDelete,
 	}
 
 	converter := bindings.NewTypeConverter()
@@ -1083,56 +1089,25 @@ func nsxtPolicySegmentQosProfileSetInStruct(d *schema.ResourceData) (*data.Struc
 }
 
 func nsxtPolicySegmentSecurityProfileSetInStruct(d *schema.ResourceData) (*data.StructValue, error) {
-	segmentProfileMapID := "default"
-
-	spoofguardProfilePath := ""
-	securityProfilePath := ""
-	revision := int64(0)
-	oldProfiles, newProfiles := d.GetChange("security_profile")
-	shouldDelete := false
-	if len(newProfiles.([]interface{})) > 0 {
-		profileMap := newProfiles.([]interface{})[0].(map[string]interface{})
-
-		spoofguardProfilePath = profileMap["spoofguard_profile_path"].(string)
-		securityProfilePath = profileMap["security_profile_path"].(string)
-		if len(profileMap["binding_map_path"].(string)) > 0 {
-			segmentProfileMapID = getPolicyIDFromPath(profileMap["binding_map_path"].(string))
-		}
-
-		revision = int64(profileMap["revision"].(int))
-	} else {
-		if len(oldProfiles.([]interface{})) == 0 {
-			return nil, nil
-		}
-		// Profile should be deleted
-		segmentProfileMapID, revision = getOldProfileDataForRemoval(oldProfiles)
-		shouldDelete = true
+	config := getPolicySegmentProfileMapConfig(d, "security_profile")
+	if config == nil {
+		return nil, nil
 	}
 
 	resourceType := "SegmentSecurityProfileBindingMap"
 	securityMap := model.SegmentSecurityProfileBindingMap{
-		ResourceType: &resourceType,
-		Id:           &segmentProfileMapID,
-	}
-
-	if len(oldProfiles.([]interface{})) > 0 {
-		// This is an update
-		securityMap.Revision = &revision
-	}
-
-	if len(spoofguardProfilePath) > 0 {
-		securityMap.SpoofguardProfilePath = &spoofguardProfilePath
-	}
-
-	if len(securityProfilePath) > 0 {
-		securityMap.SegmentSecurityProfilePath = &securityProfilePath
+		ResourceType:               &resourceType,
+		Id:                         &config.id,
+		Revision:                   config.revision,
+		SpoofguardProfilePath:      config.getProfilePath("spoofguard_profile_path"),
+		SegmentSecurityProfilePath: config.getProfilePath("security_profile_path"),
 	}
 
 	childConfig := model.ChildSegmentSecurityProfileBindingMap{
 		ResourceType:                     "ChildSegmentSecurityProfileBindingMap",
 		SegmentSecurityProfileBindingMap: &securityMap,
-		Id:                               &segmentProfileMapID,
-		MarkedForDelete:                  &shouldDelete,
+		Id:                               &config.id,
+		MarkedForDelete:                  &config.shouldDelete,
 	}
 
 	converter := bindings.NewTypeConverter()
@@ -0,0 +1,174 @@
+/* Copyright © 2023 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var tlsInspectionCipherSuiteValues = []string{
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA,
+}
+
+var tlsInspectionVersionValues = []string{
+	model.TlsInspectionInternalProfile_CLIENT_MAX_TLS_VERSION_0,
+	model.TlsInspectionInternalProfile_CLIENT_MAX_TLS_VERSION_1,
+	model.TlsInspectionInternalProfile_CLIENT_MAX_TLS_VERSION_2,
+}
+
+var tlsInspectionConfigSettingValues = []string{
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_BALANCED,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_FIDELITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_SECURITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_CUSTOM,
+}
+
+var tlsInspectionCryptoEnforcementValues = []string{
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_ENFORCE,
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_TRANSPARENT,
+}
+
+var tlsInspectionDecryptionFailActionValues = []string{
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BYPASS,
+}
+
+func getTLSInspectionCipherSuitesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(tlsInspectionCipherSuiteValues, false),
+		},
+	}
+}
+
+func getTLSInspectionVersionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(tlsInspectionVersionValues, false),
+	}
+}
+
+// Attributes shared between internal and external TLS inspection (decryption) profiles
+func getTLSInspectionProfileCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"nsx_id":                 getNsxIDSchema(),
+		"path":                   getPathSchema(),
+		"display_name":           getDisplayNameSchema(),
+		"description":            getDescriptionSchema(),
+		"revision":               getRevisionSchema(),
+		"tag":                    getTagsSchema(),
+		"client_cipher_suites":   getTLSInspectionCipherSuitesSchema("Client cipher suites, required if crypto_enforcement is ENFORCE"),
+		"client_max_tls_version": getTLSInspectionVersionSchema("Client maximum TLS version to enforce"),
+		"client_min_tls_version": getTLSInspectionVersionSchema("Client minimum TLS version to enforce"),
+		"server_cipher_suites":   getTLSInspectionCipherSuitesSchema("Server cipher suites, required if crypto_enforcement is ENFORCE"),
+		"server_max_tls_version": getTLSInspectionVersionSchema("Server maximum TLS version to enforce"),
+		"server_min_tls_version": getTLSInspectionVersionSchema("Server minimum TLS version to enforce"),
+		"crypto_enforcement": {
+			Type:         schema.TypeString,
+			Description:  "Terminate the connection if TLS versions or ciphers are not permitted (ENFORCE), or let it pass (TRANSPARENT)",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionCryptoEnforcementValues, false),
+			Default:      model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_ENFORCE,
+		},
+		"decryption_fail_action": {
+			Type:         schema.TypeString,
+			Description:  "Action to take when decryption fails",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionDecryptionFailActionValues, false),
+			Default:      model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+		},
+		"ocsp_must_staple": {
+			Type:        schema.TypeBool,
+			Description: "Activate OCSP must staple",
+			Optional:    true,
+			Default:     false,
+		},
+		"tls_config_setting": {
+			Type:         schema.TypeString,
+			Description:  "Pre-defined TLS config setting",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(tlsInspectionConfigSettingValues, false),
+			Default:      model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_BALANCED,
+		},
+		"crl_paths": {
+			Type:        schema.TypeSet,
+			Description: "Certificate revocation list paths",
+			Optional:    true,
+			Elem:        getElemPolicyPathSchema(),
+		},
+		"trusted_ca_bundle_paths": {
+			Type:        schema.TypeSet,
+			Description: "Trusted CA bundle paths",
+			Optional:    true,
+			Elem:        getElemPolicyPathSchema(),
+		},
+		"idle_connection_timeout": {
+			Type:         schema.TypeInt,
+			Description:  "Timeout the connection when kept idle",
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"attention": {
+			Type:        schema.TypeString,
+			Description: "Indication of TLS version or cipher pre-defined settings mismatch",
+			Computed:    true,
+		},
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving TLS Inspection Profile", err)
+}
+
+func resourceNsxtPolicyTLSInspectionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Profile", id, err)
+	}
+
+	return nil
+}
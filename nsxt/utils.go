@@ -702,22 +702,38 @@ func handlePagination(lister func(*paginationInfo) error) (int64, error) {
 }
 
 func getContextSchema() *schema.Schema {
+	return getContextSchemaExtended(false, false)
+}
+
+func getContextSchemaExtended(isRequired bool, isVPC bool) *schema.Schema {
+	elemSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Description:  "Id of the project which the resource belongs to.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+	if isVPC {
+		elemSchema["vpc_id"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "Id of the VPC which the resource belongs to.",
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		}
+	}
+
 	return &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "Resource context",
-		Optional:    true,
+		Optional:    !isRequired,
+		Required:    isRequired,
 		MaxItems:    1,
 		ForceNew:    true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"project_id": {
-					Type:         schema.TypeString,
-					Description:  "Id of the project which the resource belongs to.",
-					Required:     true,
-					ForceNew:     true,
-					ValidateFunc: validation.StringIsNotWhiteSpace,
-				},
-			},
+			Schema: elemSchema,
 		},
 	}
 }
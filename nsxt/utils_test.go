@@ -208,6 +208,18 @@ func getTestCertificateName(isClient bool) string {
 	return os.Getenv("NSXT_TEST_CERTIFICATE_NAME")
 }
 
+func getTestCACertificateName() string {
+	return os.Getenv("NSXT_TEST_CA_CERTIFICATE_NAME")
+}
+
+func getTestCABundleName() string {
+	return os.Getenv("NSXT_TEST_CA_BUNDLE_NAME")
+}
+
+func getTestCrlPath() string {
+	return os.Getenv("NSXT_TEST_CRL_PATH")
+}
+
 func getTestLBServiceName() string {
 	return os.Getenv("NSXT_TEST_LB_SERVICE_NAME")
 }
@@ -236,10 +248,46 @@ func getTestLdapBaseDN() string {
 	return os.Getenv("NSXT_TEST_LDAP_BASE_DN")
 }
 
+func getTestLdapHost() string {
+	return os.Getenv("NSXT_TEST_LDAP_HOST")
+}
+
+func getTestAdNetbiosName() string {
+	return os.Getenv("NSXT_TEST_AD_NETBIOS_NAME")
+}
+
 func getTestManagerClusterNode() string {
 	return os.Getenv("NSXT_TEST_MANAGER_CLUSTER_NODE")
 }
 
+func getTestManagerCertificateID() string {
+	return os.Getenv("NSXT_TEST_MANAGER_CERTIFICATE_ID")
+}
+
+func getTestManagerClusterCertificateID() string {
+	return os.Getenv("NSXT_TEST_MANAGER_CLUSTER_CERTIFICATE_ID")
+}
+
+func getTestBackupServer() string {
+	return os.Getenv("NSXT_TEST_BACKUP_SERVER")
+}
+
+func getTestBackupUsername() string {
+	return os.Getenv("NSXT_TEST_BACKUP_USERNAME")
+}
+
+func getTestBackupPassword() string {
+	return os.Getenv("NSXT_TEST_BACKUP_PASSWORD")
+}
+
+func getTestServiceProfilePath() string {
+	return os.Getenv("NSXT_TEST_SERVICE_PROFILE_PATH")
+}
+
+func getTestServiceSegmentPath() string {
+	return os.Getenv("NSXT_TEST_SERVICE_SEGMENT_PATH")
+}
+
 func testAccEnvDefined(t *testing.T, envVar string) {
 	if len(os.Getenv(envVar)) == 0 {
 		t.Skipf("This test requires %s environment variable to be set", envVar)
@@ -319,6 +367,13 @@ func testAccOnlyMultitenancy(t *testing.T) {
 	}
 }
 
+func testAccOnlyVPC(t *testing.T) {
+	testAccNSXVersion(t, "4.1.2")
+	if !testAccIsMultitenancy() {
+		t.Skipf("This test requires a multitenancy environment")
+	}
+}
+
 func testAccNSXGlobalManagerSitePrecheck(t *testing.T) {
 	if testAccIsGlobalManager() && getTestSiteName() == "" {
 		str := fmt.Sprintf("%s must be set for this acceptance test", "NSXT_TEST_SITE_NAME")
@@ -699,6 +754,35 @@ func testAccNsxtPolicyMultitenancyContext() string {
 	return ""
 }
 
+// VPC resources under test are created within parent VPC nsxt_vpc.parent
+func testAccNsxtVpcParentTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_vpc" "parent" {
+  context {
+    project_id = "%s"
+  }
+  display_name = "terraform-test-vpc"
+}
+`, os.Getenv("NSXT_PROJECT_ID"))
+}
+
+func testAccNsxtVpcContext() string {
+	return fmt.Sprintf(`
+  context {
+    project_id = "%s"
+    vpc_id     = nsxt_vpc.parent.nsx_id
+  }
+`, os.Getenv("NSXT_PROJECT_ID"))
+}
+
+func testAccGetVpcSessionContext(rs *terraform.ResourceState) tf_api.SessionContext {
+	return tf_api.SessionContext{
+		ClientType: tf_api.VPC,
+		ProjectID:  rs.Primary.Attributes["context.0.project_id"],
+		VPCID:      rs.Primary.Attributes["context.0.vpc_id"],
+	}
+}
+
 func testAccResourceNsxtPolicyImportIDRetriever(resourceID string) func(*terraform.State) (string, error) {
 	return func(s *terraform.State) (string, error) {
 
@@ -732,6 +816,7 @@ func testAccGenerateTLSKeyPair() (string, string, error) {
 		},
 		NotBefore: time.Now(),
 		NotAfter:  time.Now().Add(time.Hour * 24 * 180),
+		DNSNames:  []string{"acme.example.com"},
 
 		KeyUsage:              x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
@@ -281,6 +281,63 @@ func validateIPCidr() schema.SchemaValidateFunc {
 	}
 }
 
+func isIPv6Address(v string) bool {
+	ip := net.ParseIP(v)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(v)
+	}
+	return ip != nil && ip.To4() == nil
+}
+
+func isIPv6LinkLocal(v string) bool {
+	ip := net.ParseIP(v)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(v)
+	}
+	return ip != nil && ip.To4() == nil && ip.IsLinkLocalUnicast()
+}
+
+// Next hop address family must match the routed network. IPv6 link-local next hop
+// is only unique per link, hence it requires the interface to be specified.
+func validateStaticRouteNextHop(network string, nextHop string, scope string) error {
+	if nextHop == "" {
+		return nil
+	}
+
+	if isIPv6Address(network) != isIPv6Address(nextHop) {
+		return fmt.Errorf("next hop %s does not match address family of network %s", nextHop, network)
+	}
+
+	if isIPv6LinkLocal(nextHop) && scope == "" {
+		return fmt.Errorf("interface must be specified for IPv6 link-local next hop %s", nextHop)
+	}
+
+	return nil
+}
+
+// Link-local networks are never routed, hence can not be advertised
+func validateRoutableCidr() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if !isCidr(v, true, false) {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a valid CIDR, got: %s", k, v))
+			return
+		}
+
+		if isIPv6LinkLocal(v) {
+			es = append(es, fmt.Errorf(
+				"expected %s to contain a routable CIDR, got IPv6 link-local: %s", k, v))
+		}
+		return
+	}
+}
+
 func isPowerOfTwo(num int) bool {
 	for num >= 2 {
 		if num%2 != 0 {
@@ -71,11 +71,19 @@ def new_func_call_setup(api, subs_dict):
     return '%s(%s)' % (g[0], ', '.join(arg_list))
 
 
-def api_func_call_setup(api, subs_dict):
-    g = parse_api_call(subs_dict['func_def'])
-    arg_list = get_arglist(g[2])
+def context_arg_list(subs_dict, arg_list):
+    # Parameters which are not applicable for given context, e.g. domain for VPC
+    arg_list = [arg for arg in arg_list if arg not in subs_dict['ignore_params']]
     if subs_dict['type'] == "Multitenancy":
         arg_list = ['utl.DefaultOrgID', 'c.ProjectID'] + arg_list
+    elif subs_dict['type'] == "VPC":
+        arg_list = ['utl.DefaultOrgID', 'c.ProjectID', 'c.VPCID'] + arg_list
+    return arg_list
+
+
+def api_func_call_setup(api, subs_dict):
+    g = parse_api_call(subs_dict['func_def'])
+    arg_list = context_arg_list(subs_dict, get_arglist(g[2]))
     return '%s(%s)' % (g[1], ', '.join(arg_list))
 
 
@@ -86,8 +94,8 @@ def patch_func_call_setup(api, subs_dict):
         for n in range(0, len(arg_list)):
             if arg_list[n] == subs_dict['var_name']:
                 arg_list[n] = 'gmObj.(%s.%s)' % (subs_dict['model_import'], subs_dict['model_name'])
-    elif subs_dict['type'] == "Multitenancy":
-        arg_list = ['utl.DefaultOrgID', 'c.ProjectID'] + arg_list
+    else:
+        arg_list = context_arg_list(subs_dict, arg_list)
     return '%s(%s)' % (g[1], ', '.join(arg_list))
 
 
@@ -285,7 +293,8 @@ def write_api_file(api, out_dir, api_file_template):
                 "list_model_import": list_model_import,
                 "list_main_model_import": list_main_model_import,
                 "func_def": func_def,
-                "type": pkg['type']
+                "type": pkg['type'],
+                "ignore_params": pkg.get('ignore_params', [])
             })
             if api_name != 'List' and model_import == main_model_import:
                 api['template_type'] = "NoConvert"